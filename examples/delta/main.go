@@ -5,8 +5,6 @@ import (
 	"image/color"
 	"time"
 
-	math "github.com/chewxy/math32"
-
 	"github.com/funatsufumiya/ebiten_fade/fade"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
@@ -24,7 +22,7 @@ func (g *Game) Update() error {
 
 func (g *Game) Draw(screen *ebiten.Image) {
 	// loop time: 2.5 (sec)
-	t := math.Mod(float32(time.Since(startTime).Seconds()), 2.5)
+	t, _ := fade.Loop.Apply(float32(time.Since(startTime).Seconds()), 2.5)
 
 	// fadein: 0.5, static: 1.0, fadeout: 0.5, delta: 100
 	fade.Delta(t, 0.5, 1.0, 0.5, 100, func(delta float32) {
@@ -34,16 +32,15 @@ func (g *Game) Draw(screen *ebiten.Image) {
 
 	// fadein: 0.5, static: 1.0, fadeout: 0.5, delta: 100
 	fade.DeltaMore(t, 0.5, 1.0, optional.Some[float32](0.5), 100, func(delta, alpha, rateEasing, rateTime float32, phase fade.Phase) {
-		col := color.NRGBA{R: 255, G: 0, B: 0, A: uint8(alpha * 255)}
+		col := fade.NRGBAWithAlpha(color.NRGBA{R: 255, G: 0, B: 0, A: 255}, alpha)
 		vector.DrawFilledCircle(screen, 220, 100+delta, 50, col, false)
 	}, fade.Linear, fade.Out, fade.Linear, fade.Out)
 
 	// fadein: 0.5, static: 1.0, fadeout: 0.5, delta: (30, 100)
-	deltaVec := [2]float32{30, 100}
-	fade.DeltaMore(t, 0.5, 1.0, optional.Some[float32](0.5), 1, func(delta, alpha, rateEasing, rateTime float32, phase fade.Phase) {
-		col := color.NRGBA{R: 255, G: 0, B: 0, A: uint8(alpha * 255)}
-		vector.DrawFilledCircle(screen, 340+deltaVec[0], 100+deltaVec[1], 50, col, false)
-	}, fade.Linear, fade.Out, fade.Linear, fade.Out)
+	deltaVec := fade.NewLerperTween(fade.Vec2{}, fade.Vec2{X: 30, Y: 100})
+	d, sample := deltaVec.More(t, 0.5, 1.0, optional.Some[float32](0.5), fade.Linear, fade.Out, fade.Linear, fade.Out)
+	col := fade.NRGBAWithAlpha(color.NRGBA{R: 255, G: 0, B: 0, A: 255}, sample.Alpha)
+	vector.DrawFilledCircle(screen, 340+d.X, 100+d.Y, 50, col, false)
 
 	// Advanced usage: show rateEasing, rateTime, phase
 	fade.Advanced(
@@ -5,8 +5,6 @@ import (
 	"image/color"
 	"time"
 
-	math "github.com/chewxy/math32"
-
 	"github.com/funatsufumiya/ebiten_fade/fade"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
@@ -24,7 +22,7 @@ func (g *Game) Update() error {
 
 func (g *Game) Draw(screen *ebiten.Image) {
 	// loop time: 2.5 (sec)
-	t := math.Mod(float32(time.Since(startTime).Seconds()), 2.5)
+	t, _ := fade.Loop.Apply(float32(time.Since(startTime).Seconds()), 2.5)
 
 	// fadein: 0.5, static: 1.0, fadeout: 0.5, delta: 100, cubic (out)
 	fade.DeltaMore(t, 0.5, 1.0, optional.Some[float32](0.5), 100, func(delta, alpha, rateEasing, rateTime float32, phase fade.Phase) {
@@ -34,13 +32,13 @@ func (g *Game) Draw(screen *ebiten.Image) {
 
 	// fadein: 0.5, static: 1.0, fadeout: 0.5, delta: 100, bounce in, linear out
 	fade.DeltaMore(t, 0.5, 1.0, optional.Some[float32](0.5), 100, func(delta, alpha, rateEasing, rateTime float32, phase fade.Phase) {
-		col := color.NRGBA{R: 255, G: 0, B: 0, A: uint8(alpha * 255)}
+		col := fade.NRGBAWithAlpha(color.NRGBA{R: 255, G: 0, B: 0, A: 255}, alpha)
 		vector.DrawFilledCircle(screen, 220, 100+delta, 50, col, false)
 	}, fade.Bounce, fade.Out, fade.Linear, fade.In)
 
 	// fadein: 0.5, static: 1.0, fadeout: 0.5, cubic (in)
 	fade.AlphaMore(t, 0.5, 1.0, optional.Some[float32](0.5), func(alpha, rateEasing, rateTime float32, phase fade.Phase) {
-		col := color.NRGBA{R: 255, G: 0, B: 0, A: uint8(alpha * 255)}
+		col := fade.NRGBAWithAlpha(color.NRGBA{R: 255, G: 0, B: 0, A: 255}, alpha)
 		vector.DrawFilledCircle(screen, 340, 100, 50, col, false)
 	}, fade.Cubic, fade.In, fade.Cubic, fade.In)
 
@@ -18,10 +18,10 @@ type Game struct{}
 func (g *Game) Update() error {
 	// Space or Enter toggles fade
 	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
-		if fader.IsStarted() && !fader.IsFadeOutStarted() {
+		if fader.IsFadeOutStarted() {
+			fader.FadeIn()
+		} else {
 			fader.FadeOut(true)
-		} else if fader.IsFinished() {
-			fader.Start()
 		}
 	}
 	return nil
@@ -29,7 +29,7 @@ func (g *Game) Update() error {
 
 func (g *Game) Draw(screen *ebiten.Image) {
 	fader.Alpha(func(a float32) {
-		col := color.NRGBA{R: 255, G: 0, B: 0, A: uint8(a * 255)}
+		col := fade.NRGBAWithAlpha(color.NRGBA{R: 255, G: 0, B: 0, A: 255}, a)
 		vector.DrawFilledCircle(screen, 100, 100, 50, col, false)
 	})
 	ebitenutil.DebugPrintAt(screen, "click to toggle fade", 100, 100)
@@ -56,9 +56,9 @@ func init() {
 }
 
 func (g *Game) MousePressed(x, y int, button ebiten.MouseButton) {
-	if fader.IsStarted() && !fader.IsFadeOutStarted() {
+	if fader.IsFadeOutStarted() {
+		fader.FadeIn()
+	} else {
 		fader.FadeOut(true)
-	} else if fader.IsFinished() {
-		fader.Start()
 	}
 }
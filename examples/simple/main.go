@@ -5,8 +5,6 @@ import (
 	"image/color"
 	"time"
 
-	math "github.com/chewxy/math32"
-
 	"github.com/funatsufumiya/ebiten_fade/fade"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
@@ -24,11 +22,11 @@ func (g *Game) Update() error {
 
 func (g *Game) Draw(screen *ebiten.Image) {
 	// loop time: 2.5 (sec)
-	t := math.Mod(float32(time.Since(startTime).Seconds()), 2.5)
+	t, _ := fade.Loop.Apply(float32(time.Since(startTime).Seconds()), 2.5)
 
 	// fadein: 0.5, static: 1.0, fadeout: 0.5
 	fade.Alpha(t, 0.5, 1.0, 0.5, func(a float32) {
-		col := color.NRGBA{R: 255, G: 0, B: 0, A: uint8(a * 255)}
+		col := fade.NRGBAWithAlpha(color.NRGBA{R: 255, G: 0, B: 0, A: 255}, a)
 		vector.DrawFilledCircle(screen, 100, 100, 50, col, false)
 	})
 
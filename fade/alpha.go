@@ -0,0 +1,35 @@
+package fade
+
+import "image/color"
+
+// ClampAlpha clamps an alpha that overshot, as Back, Elastic and Bounce
+// easings do, to [0, 1]. NaN becomes 0.
+func ClampAlpha(alpha float32) float32 {
+	if !(alpha > 0) {
+		return 0
+	}
+	return min(alpha, 1)
+}
+
+// AlphaByte converts alpha to a rounded, clamped 8-bit alpha. Use it instead
+// of uint8(alpha * 255), which wraps around when alpha overshoots.
+func AlphaByte(alpha float32) uint8 {
+	return uint8(ClampAlpha(alpha)*255 + 0.5)
+}
+
+// NRGBAWithAlpha returns c with its alpha multiplied by the clamped alpha.
+func NRGBAWithAlpha(c color.NRGBA, alpha float32) color.NRGBA {
+	c.A = uint8(float32(c.A)*ClampAlpha(alpha) + 0.5)
+	return c
+}
+
+// ColorWithAlpha returns c with its opacity multiplied by the clamped alpha.
+// Go colors are premultiplied, so all four channels are scaled.
+func ColorWithAlpha(c color.Color, alpha float32) color.Color {
+	a := ClampAlpha(alpha)
+	r, g, b, ca := c.RGBA()
+	scale := func(v uint32) uint16 {
+		return uint16(float32(v)*a + 0.5)
+	}
+	return color.RGBA64{R: scale(r), G: scale(g), B: scale(b), A: scale(ca)}
+}
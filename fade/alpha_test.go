@@ -0,0 +1,58 @@
+package fade
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestClampAlpha(t *testing.T) {
+	for _, tc := range []struct{ in, want float32 }{
+		{-0.2, 0}, {0, 0}, {0.5, 0.5}, {1, 1}, {1.3, 1},
+		{float32(math.NaN()), 0}, {float32(math.Inf(1)), 1}, {float32(math.Inf(-1)), 0},
+	} {
+		if got := ClampAlpha(tc.in); got != tc.want {
+			t.Errorf("ClampAlpha(%v) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestAlphaByte(t *testing.T) {
+	for _, tc := range []struct {
+		in   float32
+		want uint8
+	}{
+		{-0.5, 0}, {0, 0}, {0.5, 128}, {1, 255}, {1.3, 255},
+	} {
+		if got := AlphaByte(tc.in); got != tc.want {
+			t.Errorf("AlphaByte(%v) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestColorAlpha(t *testing.T) {
+	c := color.NRGBA{R: 200, G: 100, B: 50, A: 200}
+	if got, want := NRGBAWithAlpha(c, 0.5), (color.NRGBA{R: 200, G: 100, B: 50, A: 100}); got != want {
+		t.Errorf("NRGBAWithAlpha(0.5) = %v, want %v", got, want)
+	}
+	if got := NRGBAWithAlpha(c, 1.4); got != c {
+		t.Errorf("NRGBAWithAlpha(1.4) = %v, want %v", got, c)
+	}
+	if got := NRGBAWithAlpha(c, -0.4); got.A != 0 {
+		t.Errorf("NRGBAWithAlpha(-0.4) = %v, want transparent", got)
+	}
+
+	// Go colors are premultiplied, so every channel is halved.
+	got := ColorWithAlpha(color.RGBA{R: 200, G: 100, B: 50, A: 255}, 0.5)
+	r, g, b, a := got.RGBA()
+	wr, wg, wb, wa := color.RGBA{R: 100, G: 50, B: 25, A: 128}.RGBA()
+	for _, ch := range []struct{ got, want uint32 }{{r, wr}, {g, wg}, {b, wb}, {a, wa}} {
+		if d := int(ch.got) - int(ch.want); d > 256 || d < -256 {
+			t.Errorf("ColorWithAlpha(0.5) = %v, want about {100 50 25 128}", got)
+			break
+		}
+	}
+	if r, g, b, a := ColorWithAlpha(color.White, 2).RGBA(); r != 0xffff || g != 0xffff || b != 0xffff || a != 0xffff {
+		t.Errorf("ColorWithAlpha(white, 2) = (%x, %x, %x, %x), want opaque white", r, g, b, a)
+	}
+}
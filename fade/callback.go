@@ -0,0 +1,224 @@
+package fade
+
+// phaseSequence describes the phases a fader goes through in time order.
+// Ordinal k is the k-th phase slot; phaseAt reports its phase and whether it
+// lasts any time at all.
+type phaseSequence interface {
+	phaseAt(ordinal int) (phase Phase, nonEmpty bool)
+}
+
+// phaseTracker remembers the last observed phase slot of a fader and fires
+// callbacks for every transition passed since then.
+type phaseTracker struct {
+	onChange    func(from, to Phase)
+	onFinished  func()
+	active      bool
+	last        int
+	finished    bool
+	generation  int
+	dispatching bool
+}
+
+// reset starts tracking at ordinal without firing anything.
+func (p *phaseTracker) reset(ordinal int) {
+	p.active = true
+	p.last = ordinal
+	p.finished = false
+	p.generation++
+}
+
+func (p *phaseTracker) stop() {
+	p.active = false
+	p.generation++
+}
+
+// advance fires OnPhaseChange once for every transition between the last
+// observed slot and current, skipping empty slots in between, then fires
+// OnFinished if the fader just finished.
+func (p *phaseTracker) advance(seq phaseSequence, current int, finished bool) {
+	if !p.active || p.dispatching {
+		return
+	}
+	p.dispatching = true
+	defer func() { p.dispatching = false }()
+
+	generation := p.generation
+	from, _ := seq.phaseAt(p.last)
+	if current < p.last {
+		// Time went backwards, as when FadeIn cancels a fade-out that
+		// has just begun. Report the way back if the phase differs.
+		p.last = current
+		if to, _ := seq.phaseAt(current); to != from && p.onChange != nil {
+			p.onChange(from, to)
+			if p.generation != generation {
+				return
+			}
+		}
+		from, _ = seq.phaseAt(current)
+	}
+	for k := p.last + 1; k <= current; k++ {
+		p.last = k
+		to, nonEmpty := seq.phaseAt(k)
+		if (k < current && !nonEmpty) || to == from {
+			continue
+		}
+		if p.onChange != nil {
+			p.onChange(from, to)
+			if p.generation != generation {
+				// the callback restarted or stopped the fader
+				return
+			}
+		}
+		from = to
+	}
+
+	if finished && !p.finished {
+		p.finished = true
+		if p.onFinished != nil {
+			p.onFinished()
+		}
+	}
+}
+
+// --- InteractiveFader ---
+
+// OnPhaseChange sets a callback fired once for every phase transition.
+// Callbacks are fired from Update and from any query method (Alpha, Delta,
+// IsFinished, Phase, ...), so transitions are never missed even if several
+// happened between two calls.
+func (f *InteractiveFader) OnPhaseChange(fn func(from, to Phase)) {
+	f.phases.onChange = fn
+}
+
+// OnFinished sets a callback fired once when the fade-out completes.
+func (f *InteractiveFader) OnFinished(fn func()) {
+	f.phases.onFinished = fn
+}
+
+// Update fires pending callbacks. Call it once per frame if the fader is not
+// otherwise queried.
+func (f *InteractiveFader) Update() {
+	f.dispatch()
+}
+
+// Phase returns the current phase.
+func (f *InteractiveFader) Phase() Phase {
+	f.dispatch()
+	p, _ := f.phaseAt(f.ordinal())
+	return p
+}
+
+func (f *InteractiveFader) dispatch() {
+	if !f.phases.active {
+		return
+	}
+	f.phases.advance(f, f.ordinal(), f.finished())
+}
+
+// ordinal returns the current phase slot: 0 delay, 1 fade-in, 2 static,
+// 3 fade-out, with four more slots for every FadeIn reversal.
+func (f *InteractiveFader) ordinal() int {
+	base := f.reversals * 4
+	t, staticSec, _, ok := f.timing()
+	switch {
+	case !ok:
+		return base + 1
+	case t < 0:
+		return base
+	case t < f.FadeInSec:
+		return base + 1
+	case t < f.FadeInSec+staticSec:
+		return base + 2
+	}
+	return base + 3
+}
+
+func (f *InteractiveFader) phaseAt(ordinal int) (Phase, bool) {
+	_, staticSec, fadeOut, _ := f.timing()
+	switch ordinal % 4 {
+	case 0:
+		return Delay, ordinal == 0 && f.DelaySec > f.PreRollSec
+	case 1:
+		return FadeIn, f.FadeInSec > 0
+	case 2:
+		return Static, staticSec > 0
+	}
+	return FadeOut, fadeOut > 0
+}
+
+// --- NonInteractiveFader ---
+
+// OnPhaseChange sets a callback fired once for every phase transition,
+// including those between loop iterations. Callbacks are fired from Update
+// and from any query method (Alpha, Delta, IsFinished, Phase, ...), so
+// transitions are never missed even if several happened between two calls.
+func (f *NonInteractiveFader) OnPhaseChange(fn func(from, to Phase)) {
+	f.phases.onChange = fn
+}
+
+// OnFinished sets a callback fired once when the fader finishes.
+func (f *NonInteractiveFader) OnFinished(fn func()) {
+	f.phases.onFinished = fn
+}
+
+// Update fires pending callbacks. Call it once per frame if the fader is not
+// otherwise queried.
+func (f *NonInteractiveFader) Update() {
+	f.dispatch()
+}
+
+// Phase returns the current phase.
+func (f *NonInteractiveFader) Phase() Phase {
+	f.dispatch()
+	p, _ := f.phaseAt(f.ordinal())
+	return p
+}
+
+func (f *NonInteractiveFader) dispatch() {
+	if !f.phases.active {
+		return
+	}
+	f.phases.advance(f, f.ordinal(), f.finished())
+}
+
+// phaseOrder returns the phases of an iteration in time order along with
+// their durations. PingPong plays odd iterations backwards.
+func (f *NonInteractiveFader) phaseOrder(iteration int) ([3]Phase, [3]float32) {
+	if f.loopMode.kind == loopPingPong && iteration%2 == 1 {
+		return [3]Phase{FadeOut, Static, FadeIn}, [3]float32{f.fadeOutSec(), f.StaticSec, f.FadeInSec}
+	}
+	return [3]Phase{FadeIn, Static, FadeOut}, [3]float32{f.FadeInSec, f.StaticSec, f.fadeOutSec()}
+}
+
+// ordinal returns the current phase slot: 0 for the delay, then three per
+// iteration.
+func (f *NonInteractiveFader) ordinal() int {
+	if !f.started {
+		return 1
+	}
+	t := f.playTime()
+	if t < 0 {
+		return 0
+	}
+	period := f.period()
+	_, iteration := f.loopMode.Apply(t, period)
+	u := t - float32(iteration)*period
+	_, durations := f.phaseOrder(iteration)
+	idx := 2
+	switch {
+	case u < durations[0]:
+		idx = 0
+	case u < durations[0]+durations[1]:
+		idx = 1
+	}
+	return 1 + iteration*3 + idx
+}
+
+func (f *NonInteractiveFader) phaseAt(ordinal int) (Phase, bool) {
+	if ordinal == 0 {
+		return Delay, f.DelaySec > f.PreRollSec
+	}
+	k := ordinal - 1
+	phases, durations := f.phaseOrder(k / 3)
+	return phases[k%3], durations[k%3] > 0
+}
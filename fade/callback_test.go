@@ -0,0 +1,279 @@
+package fade
+
+import (
+	"slices"
+	"testing"
+
+	optional "github.com/moznion/go-optional"
+)
+
+func TestUnstartedFadersReportFadeIn(t *testing.T) {
+	clock := NewManualClock()
+	for name, f := range map[string]Fader{
+		"InteractiveFader":    NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock)),
+		"NonInteractiveFader": NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock)),
+	} {
+		if p := f.Phase(); p != FadeIn {
+			t.Errorf("%s: Phase before Start = %v, want FadeIn", name, p)
+		}
+		s := f.SampleMoreEase(nil, nil)
+		if s.Phase != FadeIn || s.Alpha != 0 {
+			t.Errorf("%s: SampleMoreEase before Start = %+v, want FadeIn with alpha 0", name, s)
+		}
+		var phase Phase
+		f.AlphaMore(func(alpha, rateEasing, rateTime float32, p Phase) { phase = p }, Linear, In, Linear, In)
+		if phase != FadeIn {
+			t.Errorf("%s: AlphaMore phase before Start = %v, want FadeIn", name, phase)
+		}
+	}
+}
+
+type phaseLog struct {
+	changes  []string
+	finished int
+}
+
+func (l *phaseLog) attach(f interface {
+	OnPhaseChange(func(from, to Phase))
+	OnFinished(func())
+}) {
+	f.OnPhaseChange(func(from, to Phase) {
+		l.changes = append(l.changes, from.String()+"->"+to.String())
+	})
+	f.OnFinished(func() { l.finished++ })
+}
+
+// expect checks the transitions fired since the last call and the total
+// number of OnFinished calls.
+func (l *phaseLog) expect(t *testing.T, step string, finished int, changes ...string) {
+	t.Helper()
+	if !slices.Equal(l.changes, changes) {
+		t.Errorf("%s: transitions = %v, want %v", step, l.changes, changes)
+	}
+	if l.finished != finished {
+		t.Errorf("%s: OnFinished fired %d times, want %d", step, l.finished, finished)
+	}
+	l.changes = nil
+}
+
+func TestCallbacksFireOncePerSkippedPhase(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock), WithDelay(0.5))
+	var log phaseLog
+	log.attach(f)
+	f.Start()
+	log.expect(t, "Start", 0)
+
+	clock.AdvanceSeconds(10)
+	f.Update()
+	log.expect(t, "one frame past the end", 1, "Delay->FadeIn", "FadeIn->Static", "Static->FadeOut")
+
+	f.Alpha(func(float32) {})
+	f.IsFinished()
+	f.Phase()
+	f.Update()
+	log.expect(t, "queries after the end", 1)
+}
+
+func TestCallbacksSkipEmptyPhases(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 0, optional.Some[float32](1), WithClock(clock))
+	var log phaseLog
+	log.attach(f)
+	f.Start()
+
+	clock.AdvanceSeconds(0.5)
+	f.Update()
+	log.expect(t, "fading in", 0)
+	clock.AdvanceSeconds(1)
+	f.Update()
+	log.expect(t, "fading out", 0, "FadeIn->FadeOut")
+	clock.AdvanceSeconds(1)
+	f.Update()
+	log.expect(t, "finished", 1)
+}
+
+func TestCallbacksAcrossLoopIterations(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock), WithLoopMode(Repeat(3)))
+	var log phaseLog
+	log.attach(f)
+	f.Start()
+
+	clock.AdvanceSeconds(7.5)
+	f.Update()
+	log.expect(t, "third iteration", 0,
+		"FadeIn->Static", "Static->FadeOut", "FadeOut->FadeIn",
+		"FadeIn->Static", "Static->FadeOut", "FadeOut->FadeIn",
+		"FadeIn->Static")
+	clock.AdvanceSeconds(5)
+	f.Update()
+	log.expect(t, "after the last iteration", 1, "Static->FadeOut")
+
+	// PingPong plays odd iterations backwards, so the phase repeats at each
+	// turn and no transition is reported there.
+	f = NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock), WithLoopMode(PingPong))
+	log = phaseLog{}
+	log.attach(f)
+	f.Start()
+	clock.AdvanceSeconds(6.5)
+	f.Update()
+	log.expect(t, "PingPong", 0, "FadeIn->Static", "Static->FadeOut", "FadeOut->Static", "Static->FadeIn")
+}
+
+func TestCallbacksFadeInReversal(t *testing.T) {
+	clock := NewManualClock()
+	f := NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock))
+	var log phaseLog
+	log.attach(f)
+	f.Start()
+
+	clock.AdvanceSeconds(1.5)
+	f.FadeOut(false)
+	clock.AdvanceSeconds(0.5)
+	f.Update()
+	log.expect(t, "fading out", 0, "FadeIn->Static", "Static->FadeOut")
+
+	f.FadeIn()
+	clock.AdvanceSeconds(0.25)
+	f.Update()
+	log.expect(t, "fading back in", 0, "FadeOut->FadeIn")
+	clock.AdvanceSeconds(1)
+	f.Update()
+	log.expect(t, "static again", 0, "FadeIn->Static")
+
+	f.FadeOut(false)
+	clock.AdvanceSeconds(2)
+	f.Update()
+	log.expect(t, "faded out", 1, "Static->FadeOut")
+
+	// Fading in after the fade-out has finished rearms OnFinished.
+	f.FadeIn()
+	clock.AdvanceSeconds(1.5)
+	f.FadeOut(false)
+	clock.AdvanceSeconds(1.5)
+	f.Update()
+	log.expect(t, "second round", 2, "FadeOut->FadeIn", "FadeIn->Static", "Static->FadeOut")
+}
+
+func TestCallbacksThatRestartOrStop(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock))
+	var changes []string
+	f.OnPhaseChange(func(from, to Phase) {
+		changes = append(changes, from.String()+"->"+to.String())
+		if to == Static {
+			f.Start()
+		}
+	})
+	finished := 0
+	f.OnFinished(func() { finished++ })
+	f.Start()
+
+	// The restart stops the rest of the frame's transitions.
+	clock.AdvanceSeconds(10)
+	f.Update()
+	if !slices.Equal(changes, []string{"FadeIn->Static"}) || finished != 0 {
+		t.Errorf("restart from a callback: transitions %v, finished %d; want [FadeIn->Static], 0", changes, finished)
+	}
+	if p := f.Phase(); p != FadeIn {
+		t.Errorf("phase after the restart = %v, want FadeIn", p)
+	}
+
+	// A fader restarted from OnFinished runs and finishes again.
+	g := NewNonInteractiveFader(1, 0, optional.Some[float32](1), WithClock(clock))
+	var log phaseLog
+	log.attach(g)
+	g.OnFinished(func() {
+		log.finished++
+		g.Start()
+	})
+	g.Start()
+	clock.AdvanceSeconds(5)
+	g.Update()
+	log.expect(t, "first run", 1, "FadeIn->FadeOut")
+	clock.AdvanceSeconds(1.5)
+	g.Update()
+	log.expect(t, "second run", 1, "FadeIn->FadeOut")
+	clock.AdvanceSeconds(1)
+	g.Update()
+	log.expect(t, "second run finished", 2)
+
+	// Stop from a callback drops the remaining transitions.
+	h := NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock), WithDelay(0.5))
+	log = phaseLog{}
+	log.attach(h)
+	h.OnPhaseChange(func(from, to Phase) {
+		log.changes = append(log.changes, from.String()+"->"+to.String())
+		h.Stop()
+	})
+	h.Start()
+	clock.AdvanceSeconds(5)
+	h.Update()
+	h.Update()
+	log.expect(t, "stopped", 0, "Delay->FadeIn")
+	if h.IsStarted() {
+		t.Error("IsStarted after Stop from a callback = true")
+	}
+}
+
+func TestCallbacksFadeInReversalWithinFrame(t *testing.T) {
+	clock := NewManualClock()
+	f := NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock))
+	var log phaseLog
+	log.attach(f)
+	f.Start()
+
+	clock.AdvanceSeconds(1.5)
+	f.FadeOut(false)
+	clock.AdvanceSeconds(0.5)
+	f.FadeIn()
+	clock.AdvanceSeconds(1)
+	f.Update()
+	log.expect(t, "reversal between two updates", 0, "FadeIn->Static", "Static->FadeOut", "FadeOut->FadeIn", "FadeIn->Static")
+}
+
+func TestFadeOutThenFadeInWithinFrame(t *testing.T) {
+	clock := NewManualClock()
+	f := NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock))
+	var log phaseLog
+	log.attach(f)
+	f.Start()
+	clock.AdvanceSeconds(1.5)
+	f.Update()
+	log.expect(t, "static", 0, "FadeIn->Static")
+
+	f.FadeOut(false)
+	f.FadeIn()
+	clock.AdvanceSeconds(0.5)
+	f.Update()
+	log.expect(t, "cancelled fade-out", 0)
+	if a, p := alphaOf(f), f.Phase(); a != 1 || p != Static || f.IsFadeOutStarted() {
+		t.Errorf("after a cancelled fade-out: alpha %v, phase %v, IsFadeOutStarted %v; want 1, Static, false", a, p, f.IsFadeOutStarted())
+	}
+
+	// A fade-out that was observed at its first instant is reported as
+	// undone.
+	f.FadeOut(false)
+	f.Phase()
+	f.FadeIn()
+	f.Update()
+	log.expect(t, "observed and cancelled fade-out", 0, "Static->FadeOut", "FadeOut->Static")
+
+	// FadeOut(false) during the fade-in only schedules the fade-out, so
+	// FadeIn cancels it and the fade-in carries on.
+	g := NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock))
+	log = phaseLog{}
+	log.attach(g)
+	g.Start()
+	clock.AdvanceSeconds(0.5)
+	g.FadeOut(false)
+	clock.AdvanceSeconds(0.25)
+	g.FadeIn()
+	if a := alphaOf(g); !approx(a, 0.75) {
+		t.Errorf("alpha after cancelling a scheduled fade-out = %v, want 0.75", a)
+	}
+	clock.AdvanceSeconds(0.5)
+	g.Update()
+	log.expect(t, "cancelled scheduled fade-out", 0, "FadeIn->Static")
+}
@@ -0,0 +1,99 @@
+package fade
+
+import (
+	"testing"
+
+	optional "github.com/moznion/go-optional"
+)
+
+func TestLocalClock(t *testing.T) {
+	clock := NewManualClock()
+	clock.AdvanceSeconds(5)
+	l := newLocalClock(clock)
+	for i, step := range []struct {
+		do   func()
+		sec  float64
+		want float64
+	}{
+		{nil, 1, 1},
+		{l.pause, 2, 1},
+		{l.pause, 1, 1},
+		{func() { l.setScale(3) }, 1, 1},
+		{l.resume, 1, 4},
+		{l.resume, 1, 7},
+		{func() { l.setScale(0.5) }, 2, 8},
+		{func() { l.setScale(-1) }, 2, 8},
+		{func() { l.setScale(1) }, 0.5, 8.5},
+	} {
+		if step.do != nil {
+			step.do()
+		}
+		clock.AdvanceSeconds(step.sec)
+		if got := l.now(); !approx(float32(got), float32(step.want)) {
+			t.Errorf("step %d: now() = %v, want %v", i, got, step.want)
+		}
+	}
+}
+
+func TestInteractiveFaderPauseAndTimeScale(t *testing.T) {
+	clock := NewManualClock()
+	f := NewInteractiveFader(2, optional.Some[float32](2), WithClock(clock))
+	f.Start()
+
+	clock.AdvanceSeconds(1)
+	f.Pause()
+	clock.AdvanceSeconds(5)
+	if a := alphaOf(f); !f.IsPaused() || !approx(a, 0.5) {
+		t.Errorf("paused: IsPaused, alpha = %v, %v; want true, 0.5", f.IsPaused(), a)
+	}
+	// Reversing while paused keeps the alpha where it is.
+	f.FadeOut(true)
+	if a := alphaOf(f); !approx(a, 0.5) {
+		t.Errorf("FadeOut while paused: alpha = %v, want 0.5", a)
+	}
+	f.Resume()
+	clock.AdvanceSeconds(0.5)
+	if a := alphaOf(f); f.IsPaused() || !approx(a, 0.25) {
+		t.Errorf("resumed: IsPaused, alpha = %v, %v; want false, 0.25", f.IsPaused(), a)
+	}
+	f.SetTimeScale(2)
+	if a := alphaOf(f); f.TimeScale() != 2 || !approx(a, 0.25) {
+		t.Errorf("SetTimeScale(2): TimeScale, alpha = %v, %v; want 2, 0.25", f.TimeScale(), a)
+	}
+	clock.AdvanceSeconds(0.3)
+	if a := alphaOf(f); !approx(a, 0) || !f.IsFinished() {
+		t.Errorf("double speed: alpha, IsFinished = %v, %v; want 0, true", a, f.IsFinished())
+	}
+}
+
+func TestNonInteractiveFaderPauseAndTimeScale(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock))
+	f.Start()
+
+	for i, step := range []struct {
+		do      func()
+		sec     float64
+		elapsed float32
+		alpha   float32
+	}{
+		{nil, 0.5, 0.5, 0.5},
+		{func() { f.SetTimeScale(0.5) }, 1, 1, 1},
+		{f.Pause, 10, 1, 1},
+		{func() { f.SetTimeScale(2) }, 10, 1, 1},
+		{f.Resume, 0.75, 2.5, 0.5},
+		{func() { f.SetTimeScale(-1) }, 5, 2.5, 0.5},
+		{func() { f.SetTimeScale(1) }, 0.25, 2.75, 0.25},
+	} {
+		if step.do != nil {
+			step.do()
+		}
+		clock.AdvanceSeconds(step.sec)
+		if e, a := f.Elapsed(), alphaOf(f); !approx(e, step.elapsed) || !approx(a, step.alpha) {
+			t.Errorf("step %d: Elapsed, alpha = %v, %v; want %v, %v", i, e, a, step.elapsed, step.alpha)
+		}
+	}
+	if f.TimeScale() != 1 || f.IsPaused() {
+		t.Errorf("TimeScale, IsPaused = %v, %v; want 1, false", f.TimeScale(), f.IsPaused())
+	}
+}
@@ -0,0 +1,285 @@
+// Package color interpolates colors in the color spaces commonly used for
+// fades. Results are color.NRGBA values that can be drawn directly or passed
+// to ebiten.ColorScale.ScaleWithColor.
+package color
+
+import (
+	"image/color"
+
+	math "github.com/chewxy/math32"
+	"github.com/funatsufumiya/ebiten_fade/fade"
+)
+
+// Space is a color space to interpolate in.
+type Space int
+
+const (
+	// SRGB interpolates gamma-encoded channels, as the scalar helpers do.
+	SRGB Space = iota
+	// LinearRGB interpolates physical light intensity.
+	LinearRGB
+	// HSV and HSL interpolate hue along the shortest way around the wheel.
+	HSV
+	HSL
+	// OKLab interpolates in a perceptually uniform space, avoiding muddy
+	// midpoints.
+	OKLab
+	// OKLCH is OKLab in polar form, keeping chroma while turning the hue the
+	// shortest way.
+	OKLCH
+)
+
+func (s Space) String() string {
+	switch s {
+	case SRGB:
+		return "srgb"
+	case LinearRGB:
+		return "linear-rgb"
+	case HSV:
+		return "hsv"
+	case HSL:
+		return "hsl"
+	case OKLab:
+		return "oklab"
+	case OKLCH:
+		return "oklch"
+	}
+	return "unknown"
+}
+
+// Lerp interpolates a and b by t in space s. Alpha is interpolated linearly.
+// A fully transparent end takes the color of the other end, so fading from
+// transparent does not pass through black. t outside [0, 1] extrapolates and
+// the result is clamped.
+func Lerp(a, b color.Color, t float32, s Space) color.NRGBA {
+	ca, cb := toNRGBA(a), toNRGBA(b)
+	switch {
+	case ca.A == 0 && cb.A == 0:
+		return color.NRGBA{}
+	case ca.A == 0:
+		ca = color.NRGBA{R: cb.R, G: cb.G, B: cb.B}
+	case cb.A == 0:
+		cb = color.NRGBA{R: ca.R, G: ca.G, B: ca.B}
+	}
+
+	va, vb := s.from(ca), s.from(cb)
+	var v [3]float32
+	for i := range v {
+		v[i] = va[i] + (vb[i]-va[i])*t
+	}
+	if h := s.hueIndex(); h >= 0 {
+		v[h] = lerpHue(va, vb, t, s)
+	}
+	c := s.to(v)
+	c.A = unit(float32(ca.A)/255 + (float32(cb.A)-float32(ca.A))/255*t)
+	return c
+}
+
+// Interpolator returns Lerp in space s as a fade.Interpolator.
+func Interpolator(s Space) fade.Interpolator[color.NRGBA] {
+	return func(a, b color.NRGBA, t float32) color.NRGBA {
+		return Lerp(a, b, t, s)
+	}
+}
+
+// NewTween returns a fade.Tween between from and to in space s.
+func NewTween(from, to color.Color, s Space) fade.Tween[color.NRGBA] {
+	return fade.NewTween(toNRGBA(from), toNRGBA(to), Interpolator(s))
+}
+
+func toNRGBA(c color.Color) color.NRGBA {
+	return color.NRGBAModel.Convert(c).(color.NRGBA)
+}
+
+// unit converts v in [0, 1] to a clamped, rounded channel value.
+func unit(v float32) uint8 {
+	if !(v > 0) {
+		return 0
+	}
+	return uint8(min(v, 1)*255 + 0.5)
+}
+
+// hueIndex returns the component holding the hue in degrees, or -1.
+func (s Space) hueIndex() int {
+	switch s {
+	case HSV, HSL:
+		return 0
+	case OKLCH:
+		return 2
+	}
+	return -1
+}
+
+// achromatic reports whether v, in a space with a hue, has no meaningful
+// hue. Saturation and chroma are both the second component.
+func (s Space) achromatic(v [3]float32) bool {
+	return s.hueIndex() >= 0 && v[1] < 1e-4
+}
+
+// lerpHue interpolates the hue the shortest way around. A gray end takes the
+// hue of the other end so that only saturation changes.
+func lerpHue(va, vb [3]float32, t float32, s Space) float32 {
+	h := s.hueIndex()
+	ha, hb := va[h], vb[h]
+	switch {
+	case s.achromatic(va) && s.achromatic(vb):
+		return 0
+	case s.achromatic(va):
+		return hb
+	case s.achromatic(vb):
+		return ha
+	}
+	d := math.Mod(hb-ha+540, 360) - 180
+	return math.Mod(ha+d*t+360, 360)
+}
+
+// from converts c to the components of s.
+func (s Space) from(c color.NRGBA) [3]float32 {
+	r, g, b := float32(c.R)/255, float32(c.G)/255, float32(c.B)/255
+	switch s {
+	case LinearRGB:
+		return [3]float32{toLinear(r), toLinear(g), toLinear(b)}
+	case HSV:
+		return rgbToHSV(r, g, b)
+	case HSL:
+		return rgbToHSL(r, g, b)
+	case OKLab:
+		return linearToOKLab(toLinear(r), toLinear(g), toLinear(b))
+	case OKLCH:
+		lab := linearToOKLab(toLinear(r), toLinear(g), toLinear(b))
+		h := math.Atan2(lab[2], lab[1]) * 180 / math.Pi
+		if h < 0 {
+			h += 360
+		}
+		return [3]float32{lab[0], math.Hypot(lab[1], lab[2]), h}
+	}
+	return [3]float32{r, g, b}
+}
+
+// to converts components of s to an opaque color.
+func (s Space) to(v [3]float32) color.NRGBA {
+	var r, g, b float32
+	switch s {
+	case LinearRGB:
+		r, g, b = fromLinear(v[0]), fromLinear(v[1]), fromLinear(v[2])
+	case HSV:
+		r, g, b = hsvToRGB(v)
+	case HSL:
+		r, g, b = hslToRGB(v)
+	case OKLab:
+		r, g, b = okLabToLinear(v)
+		r, g, b = fromLinear(r), fromLinear(g), fromLinear(b)
+	case OKLCH:
+		h := v[2] * math.Pi / 180
+		r, g, b = okLabToLinear([3]float32{v[0], v[1] * math.Cos(h), v[1] * math.Sin(h)})
+		r, g, b = fromLinear(r), fromLinear(g), fromLinear(b)
+	default:
+		r, g, b = v[0], v[1], v[2]
+	}
+	return color.NRGBA{R: unit(r), G: unit(g), B: unit(b), A: 255}
+}
+
+func toLinear(c float32) float32 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+func fromLinear(c float32) float32 {
+	if c <= 0.0031308 {
+		return c * 12.92
+	}
+	return 1.055*math.Pow(c, 1/2.4) - 0.055
+}
+
+// hueOf returns the hue in degrees of an RGB color with the given max and
+// chroma.
+func hueOf(r, g, b, mx, chroma float32) float32 {
+	if chroma == 0 {
+		return 0
+	}
+	var h float32
+	switch mx {
+	case r:
+		h = math.Mod((g-b)/chroma+6, 6)
+	case g:
+		h = (b-r)/chroma + 2
+	default:
+		h = (r-g)/chroma + 4
+	}
+	return h * 60
+}
+
+func rgbToHSV(r, g, b float32) [3]float32 {
+	mx, mn := max(r, g, b), min(r, g, b)
+	var s float32
+	if mx > 0 {
+		s = (mx - mn) / mx
+	}
+	return [3]float32{hueOf(r, g, b, mx, mx-mn), s, mx}
+}
+
+func rgbToHSL(r, g, b float32) [3]float32 {
+	mx, mn := max(r, g, b), min(r, g, b)
+	l := (mx + mn) / 2
+	var s float32
+	if d := 1 - math.Abs(2*l-1); d > 0 {
+		s = (mx - mn) / d
+	}
+	return [3]float32{hueOf(r, g, b, mx, mx-mn), s, l}
+}
+
+// hueToRGB returns the RGB of a hue at full chroma c, offset by m.
+func hueToRGB(h, c, m float32) (r, g, b float32) {
+	h = math.Mod(math.Mod(h, 360)+360, 360) / 60
+	x := c * (1 - math.Abs(math.Mod(h, 2)-1))
+	switch int(h) {
+	case 0:
+		r, g, b = c, x, 0
+	case 1:
+		r, g, b = x, c, 0
+	case 2:
+		r, g, b = 0, c, x
+	case 3:
+		r, g, b = 0, x, c
+	case 4:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+	return r + m, g + m, b + m
+}
+
+func hsvToRGB(v [3]float32) (r, g, b float32) {
+	c := v[2] * v[1]
+	return hueToRGB(v[0], c, v[2]-c)
+}
+
+func hslToRGB(v [3]float32) (r, g, b float32) {
+	c := (1 - math.Abs(2*v[2]-1)) * v[1]
+	return hueToRGB(v[0], c, v[2]-c/2)
+}
+
+// linearToOKLab and okLabToLinear follow Björn Ottosson's reference
+// implementation.
+func linearToOKLab(r, g, b float32) [3]float32 {
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+	return [3]float32{
+		0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		0.0259040371*l + 0.7827717662*m - 0.8086757660*s,
+	}
+}
+
+func okLabToLinear(v [3]float32) (r, g, b float32) {
+	l := v[0] + 0.3963377774*v[1] + 0.2158037573*v[2]
+	m := v[0] - 0.1055613458*v[1] - 0.0638541728*v[2]
+	s := v[0] - 0.0894841775*v[1] - 1.2914855480*v[2]
+	l, m, s = l*l*l, m*m*m, s*s*s
+	return 4.0767416621*l - 3.3077115913*m + 0.2309699292*s,
+		-1.2684380046*l + 2.6097574011*m - 0.3413193965*s,
+		-0.0041960863*l - 0.7034186147*m + 1.7076147010*s
+}
@@ -0,0 +1,146 @@
+package color
+
+import (
+	"image/color"
+	"testing"
+)
+
+var (
+	red     = color.NRGBA{R: 255, A: 255}
+	blue    = color.NRGBA{B: 255, A: 255}
+	magenta = color.NRGBA{R: 255, B: 255, A: 255}
+	gray    = color.NRGBA{R: 128, G: 128, B: 128, A: 255}
+)
+
+var spaces = []Space{SRGB, LinearRGB, HSV, HSL, OKLab, OKLCH}
+
+// near reports whether every channel of a and b differs by at most 1.
+func near(a, b color.NRGBA) bool {
+	d := func(x, y uint8) bool { return int(x)-int(y) <= 1 && int(y)-int(x) <= 1 }
+	return d(a.R, b.R) && d(a.G, b.G) && d(a.B, b.B) && d(a.A, b.A)
+}
+
+func TestLerpEndpointsRoundTrip(t *testing.T) {
+	colors := []color.NRGBA{
+		red, blue, magenta, gray,
+		{R: 12, G: 200, B: 99, A: 255},
+		{R: 250, G: 240, B: 5, A: 255},
+		{R: 1, G: 2, B: 3, A: 255},
+		{R: 255, G: 255, B: 255, A: 255},
+		{A: 255},
+	}
+	for _, s := range spaces {
+		for _, a := range colors {
+			for _, b := range colors {
+				if got := Lerp(a, b, 0, s); !near(got, a) {
+					t.Errorf("%v: Lerp(%v, %v, 0) = %v", s, a, b, got)
+				}
+				if got := Lerp(a, b, 1, s); !near(got, b) {
+					t.Errorf("%v: Lerp(%v, %v, 1) = %v", s, a, b, got)
+				}
+			}
+		}
+	}
+}
+
+func TestLerpMidpoints(t *testing.T) {
+	for _, tc := range []struct {
+		s    Space
+		a, b color.Color
+		want color.NRGBA
+	}{
+		{SRGB, red, blue, color.NRGBA{R: 128, B: 128, A: 255}},
+		{SRGB, color.Black, color.White, color.NRGBA{R: 128, G: 128, B: 128, A: 255}},
+		{LinearRGB, color.Black, color.White, color.NRGBA{R: 188, G: 188, B: 188, A: 255}},
+		{OKLab, red, blue, color.NRGBA{R: 140, G: 83, B: 162, A: 255}},
+		{OKLab, color.Black, color.White, color.NRGBA{R: 99, G: 99, B: 99, A: 255}},
+		{OKLCH, red, blue, color.NRGBA{R: 186, B: 194, A: 255}},
+		// red (0°) to blue (240°) turns the short way through magenta.
+		{HSV, red, blue, magenta},
+		{HSL, red, blue, magenta},
+		// red (0°) to magenta (300°) wraps through 330°.
+		{HSV, red, magenta, color.NRGBA{R: 255, B: 128, A: 255}},
+		{HSL, magenta, red, color.NRGBA{R: 255, B: 128, A: 255}},
+	} {
+		if got := Lerp(tc.a, tc.b, 0.5, tc.s); !near(got, tc.want) {
+			t.Errorf("%v: Lerp(%v, %v, 0.5) = %v, want %v", tc.s, tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestLerpHueWrapsShortestWay(t *testing.T) {
+	// Hues 350° and 10° meet at 0°, not at 180°.
+	a := color.NRGBA{R: 255, B: 43, A: 255}
+	b := color.NRGBA{R: 255, G: 43, A: 255}
+	for _, s := range []Space{HSV, HSL, OKLCH} {
+		got := Lerp(a, b, 0.5, s)
+		if got.R < 200 || got.G > 60 || got.B > 60 {
+			t.Errorf("%v: Lerp(350°, 10°, 0.5) = %v, want red", s, got)
+		}
+	}
+}
+
+func TestLerpGrayKeepsHue(t *testing.T) {
+	// A gray end has no hue, so only saturation changes and no other hue
+	// shows up on the way.
+	for _, s := range []Space{HSV, HSL} {
+		got := Lerp(gray, red, 0.5, s)
+		if got.G != got.B || got.R <= got.G {
+			t.Errorf("%v: Lerp(gray, red, 0.5) = %v, want a red tint", s, got)
+		}
+		if back := Lerp(red, gray, 0.5, s); !near(back, got) {
+			t.Errorf("%v: Lerp(red, gray, 0.5) = %v, want %v", s, back, got)
+		}
+	}
+	if got := Lerp(color.Black, color.White, 0.5, HSL); got.R != got.G || got.G != got.B {
+		t.Errorf("HSL: Lerp(black, white, 0.5) = %v, want a gray", got)
+	}
+}
+
+func TestLerpTransparentEnds(t *testing.T) {
+	for _, s := range spaces {
+		// Fading from transparent keeps the color instead of passing
+		// through black.
+		if got, want := Lerp(color.Transparent, red, 0.5, s), (color.NRGBA{R: 255, A: 128}); !near(got, want) {
+			t.Errorf("%v: Lerp(transparent, red, 0.5) = %v, want %v", s, got, want)
+		}
+		if got, want := Lerp(blue, color.NRGBA{}, 0.25, s), (color.NRGBA{B: 255, A: 191}); !near(got, want) {
+			t.Errorf("%v: Lerp(blue, transparent, 0.25) = %v, want %v", s, got, want)
+		}
+		if got := Lerp(color.Transparent, color.NRGBA{R: 9}, 0.5, s); got != (color.NRGBA{}) {
+			t.Errorf("%v: Lerp of two transparent colors = %v, want transparent", s, got)
+		}
+	}
+	if got, want := Lerp(color.NRGBA{R: 255, A: 255}, color.NRGBA{R: 255, A: 51}, 0.5, SRGB), (color.NRGBA{R: 255, A: 153}); got != want {
+		t.Errorf("alpha is not interpolated linearly: %v, want %v", got, want)
+	}
+}
+
+func TestLerpExtrapolationClamps(t *testing.T) {
+	for _, s := range spaces {
+		for _, tt := range []float32{-0.5, 1.5} {
+			got := Lerp(red, blue, tt, s)
+			if got.A != 255 {
+				t.Errorf("%v: Lerp(red, blue, %v) = %v, want opaque", s, tt, got)
+			}
+		}
+	}
+	if got := Lerp(gray, color.White, 2, SRGB); got != (color.NRGBA{R: 255, G: 255, B: 255, A: 255}) {
+		t.Errorf("SRGB: Lerp(gray, white, 2) = %v, want white", got)
+	}
+}
+
+func TestTween(t *testing.T) {
+	tw := NewTween(red, blue, OKLab)
+	if got, want := tw.At(0.5), Lerp(red, blue, 0.5, OKLab); got != want {
+		t.Errorf("NewTween(OKLab).At(0.5) = %v, want %v", got, want)
+	}
+	if got, want := Interpolator(HSV)(red, blue, 0.25), Lerp(red, blue, 0.25, HSV); got != want {
+		t.Errorf("Interpolator(HSV) = %v, want %v", got, want)
+	}
+	for s, want := range map[Space]string{SRGB: "srgb", LinearRGB: "linear-rgb", HSV: "hsv", HSL: "hsl", OKLab: "oklab", OKLCH: "oklch", Space(99): "unknown"} {
+		if got := s.String(); got != want {
+			t.Errorf("Space(%d).String() = %q, want %q", int(s), got, want)
+		}
+	}
+}
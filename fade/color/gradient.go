@@ -0,0 +1,124 @@
+package color
+
+import (
+	"image/color"
+	"sort"
+
+	"github.com/funatsufumiya/ebiten_fade/fade"
+)
+
+// Stop is a color at a position on a Gradient. Easing shapes the segment
+// that ends at this stop; a nil Easing is linear. The first stop's Easing is
+// unused.
+type Stop struct {
+	Pos    float32
+	Color  color.Color
+	Easing fade.Easer
+}
+
+// Gradient maps a progress value, such as a fade's rateEasing, onto a ramp
+// of color stops interpolated in Space.
+type Gradient struct {
+	Space Space
+	stops []Stop
+}
+
+// NewGradient returns a Gradient built from stops. Stops are sorted by Pos;
+// stops with equal Pos keep their order, allowing hard edges.
+func NewGradient(space Space, stops ...Stop) *Gradient {
+	s := make([]Stop, len(stops))
+	copy(s, stops)
+	sort.SliceStable(s, func(i, j int) bool { return s[i].Pos < s[j].Pos })
+	return &Gradient{Space: space, stops: s}
+}
+
+// NewEvenGradient returns a linear Gradient with colors spread evenly over
+// [0, 1].
+func NewEvenGradient(space Space, colors ...color.Color) *Gradient {
+	g := &Gradient{Space: space}
+	for i, c := range colors {
+		var pos float32
+		if len(colors) > 1 {
+			pos = float32(i) / float32(len(colors)-1)
+		}
+		g.stops = append(g.stops, Stop{Pos: pos, Color: c})
+	}
+	return g
+}
+
+// Add inserts a stop, keeping stops sorted.
+func (g *Gradient) Add(pos float32, c color.Color, e fade.Easer) *Gradient {
+	i := sort.Search(len(g.stops), func(i int) bool { return g.stops[i].Pos > pos })
+	g.stops = append(g.stops, Stop{})
+	copy(g.stops[i+1:], g.stops[i:])
+	g.stops[i] = Stop{Pos: pos, Color: c, Easing: e}
+	return g
+}
+
+// Stops returns a copy of the gradient's stops in position order.
+func (g *Gradient) Stops() []Stop {
+	return append([]Stop(nil), g.stops...)
+}
+
+// At returns the color at progress p. Before the first stop and after the
+// last the end colors are held. An empty Gradient is transparent.
+func (g *Gradient) At(p float32) color.NRGBA {
+	switch {
+	case len(g.stops) == 0:
+		return color.NRGBA{}
+	case p < g.stops[0].Pos:
+		return toNRGBA(g.stops[0].Color)
+	case p >= g.stops[len(g.stops)-1].Pos:
+		return toNRGBA(g.stops[len(g.stops)-1].Color)
+	}
+
+	// index of the first stop strictly after p
+	i := sort.Search(len(g.stops), func(i int) bool { return g.stops[i].Pos > p })
+	from, to := g.stops[i-1], g.stops[i]
+	rate := (p - from.Pos) / (to.Pos - from.Pos)
+	if to.Easing != nil {
+		rate = to.Easing(rate)
+	}
+	return Lerp(from.Color, to.Color, rate, g.Space)
+}
+
+// Sample returns the color for the state of a fade, using its eased rate.
+func (g *Gradient) Sample(s fade.Sample) color.NRGBA {
+	return g.At(s.RateEasing)
+}
+
+// Bake evaluates the gradient at n evenly spaced positions from the first to
+// the last stop (or over [0, 1] if the gradient has fewer than two stops).
+func (g *Gradient) Bake(n int) LUT {
+	lut := LUT{Start: 0, End: 1, Colors: make([]color.NRGBA, max(n, 0))}
+	if len(g.stops) >= 2 {
+		lut.Start, lut.End = g.stops[0].Pos, g.stops[len(g.stops)-1].Pos
+	}
+	for i := range lut.Colors {
+		p := lut.Start
+		if n > 1 {
+			p += (lut.End - lut.Start) * float32(i) / float32(n-1)
+		}
+		lut.Colors[i] = g.At(p)
+	}
+	return lut
+}
+
+// LUT is a gradient baked into a lookup table for cheap per-frame evaluation.
+type LUT struct {
+	Start, End float32
+	Colors     []color.NRGBA
+}
+
+// At returns the entry nearest to progress p, clamped to the table.
+func (l LUT) At(p float32) color.NRGBA {
+	n := len(l.Colors)
+	switch {
+	case n == 0:
+		return color.NRGBA{}
+	case n == 1 || l.End <= l.Start:
+		return l.Colors[0]
+	}
+	i := int((p-l.Start)/(l.End-l.Start)*float32(n-1) + 0.5)
+	return l.Colors[min(max(i, 0), n-1)]
+}
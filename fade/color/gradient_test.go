@@ -0,0 +1,127 @@
+package color
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/funatsufumiya/ebiten_fade/fade"
+)
+
+var (
+	black = color.NRGBA{A: 255}
+	white = color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+)
+
+func TestGradientAt(t *testing.T) {
+	g := NewGradient(SRGB,
+		Stop{Pos: 1, Color: blue},
+		Stop{Pos: 0.5, Color: red},
+		Stop{Pos: 0, Color: black},
+		Stop{Pos: 0.5, Color: white},
+	)
+	for _, tc := range []struct {
+		p    float32
+		want color.NRGBA
+	}{
+		{-1, black},
+		{0, black},
+		{0.25, color.NRGBA{R: 128, A: 255}},
+		{0.4999, red},
+		// Equal positions make a hard edge; the later stop wins from there.
+		{0.5, white},
+		{0.75, color.NRGBA{R: 128, G: 128, B: 255, A: 255}},
+		{1, blue},
+		{2, blue},
+	} {
+		if got := g.At(tc.p); !near(got, tc.want) {
+			t.Errorf("At(%v) = %v, want %v", tc.p, got, tc.want)
+		}
+	}
+
+	if got := (&Gradient{}).At(0.5); got != (color.NRGBA{}) {
+		t.Errorf("empty gradient At(0.5) = %v, want transparent", got)
+	}
+	if got := NewGradient(SRGB, Stop{Pos: 0.3, Color: red}).At(0.9); got != red {
+		t.Errorf("single stop At(0.9) = %v, want %v", got, red)
+	}
+}
+
+func TestGradientEasingAndSpace(t *testing.T) {
+	quad := func(x float32) float32 { return x * x }
+	g := NewGradient(SRGB, Stop{Pos: 0, Color: black}, Stop{Pos: 1, Color: white, Easing: quad})
+	if got, want := g.At(0.5), (color.NRGBA{R: 64, G: 64, B: 64, A: 255}); !near(got, want) {
+		t.Errorf("eased At(0.5) = %v, want %v", got, want)
+	}
+	g = NewEvenGradient(OKLab, red, blue)
+	if got, want := g.At(0.5), Lerp(red, blue, 0.5, OKLab); got != want {
+		t.Errorf("OKLab At(0.5) = %v, want %v", got, want)
+	}
+	if got, want := g.Sample(fade.Sample{Alpha: 0, RateEasing: 0.5}), g.At(0.5); got != want {
+		t.Errorf("Sample uses RateEasing: %v, want %v", got, want)
+	}
+}
+
+func TestGradientStops(t *testing.T) {
+	g := NewEvenGradient(SRGB, black, red, white)
+	g.Add(0.5, blue, nil).Add(0.25, gray, nil)
+	stops := g.Stops()
+	wantPos := []float32{0, 0.25, 0.5, 0.5, 1}
+	wantColor := []color.Color{black, gray, red, blue, white}
+	if len(stops) != len(wantPos) {
+		t.Fatalf("Stops has %d stops, want %d", len(stops), len(wantPos))
+	}
+	for i, s := range stops {
+		if s.Pos != wantPos[i] || s.Color != wantColor[i] {
+			t.Errorf("stop %d = %v at %v, want %v at %v", i, s.Color, s.Pos, wantColor[i], wantPos[i])
+		}
+	}
+
+	// Stops returns a copy, so callers cannot break the ordering.
+	stops[0].Pos = 2
+	stops[0].Color = blue
+	if got := g.At(0); got != black {
+		t.Errorf("At(0) after modifying Stops = %v, want %v", got, black)
+	}
+}
+
+func TestBakeAndLUT(t *testing.T) {
+	g := NewGradient(SRGB, Stop{Pos: 0.2, Color: black}, Stop{Pos: 0.6, Color: white})
+	lut := g.Bake(5)
+	if lut.Start != 0.2 || lut.End != 0.6 || len(lut.Colors) != 5 {
+		t.Fatalf("Bake(5) = %v..%v with %d colors", lut.Start, lut.End, len(lut.Colors))
+	}
+	for i, c := range lut.Colors {
+		if want := g.At(0.2 + 0.1*float32(i)); !near(c, want) {
+			t.Errorf("Bake(5)[%d] = %v, want %v", i, c, want)
+		}
+	}
+	for _, tc := range []struct {
+		p    float32
+		want color.NRGBA
+	}{
+		{-1, lut.Colors[0]},
+		{0.2, lut.Colors[0]},
+		{0.34, lut.Colors[1]},
+		{0.36, lut.Colors[2]},
+		{0.6, lut.Colors[4]},
+		{5, lut.Colors[4]},
+	} {
+		if got := lut.At(tc.p); got != tc.want {
+			t.Errorf("LUT.At(%v) = %v, want %v", tc.p, got, tc.want)
+		}
+	}
+
+	if got := (LUT{}).At(0.5); got != (color.NRGBA{}) {
+		t.Errorf("empty LUT.At = %v, want transparent", got)
+	}
+	one := NewGradient(SRGB, Stop{Pos: 0.5, Color: red}).Bake(3)
+	if one.Start != 0 || one.End != 1 || one.At(0.9) != red {
+		t.Errorf("single stop Bake(3) = %+v", one)
+	}
+	if n := len(g.Bake(-1).Colors); n != 0 {
+		t.Errorf("Bake(-1) has %d colors, want 0", n)
+	}
+	if got := (LUT{Start: 1, End: 1, Colors: []color.NRGBA{red, blue}}).At(1); got != red {
+		t.Errorf("degenerate LUT.At = %v, want the first entry", got)
+	}
+}
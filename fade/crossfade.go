@@ -0,0 +1,28 @@
+package fade
+
+// CrossfadeBlend selects how the two images of a crossfade are combined.
+type CrossfadeBlend int
+
+const (
+	// CrossfadeNormal draws the old image opaque and the new one over it
+	// with alpha p using source-over blending. The result is
+	// old·(1-p) + new·p without the mid-point dip of fading both images,
+	// as long as the new image is opaque. The old image is dropped at p = 1.
+	CrossfadeNormal CrossfadeBlend = iota
+	// CrossfadeAdditive draws the old image with alpha 1-p and the new one
+	// with alpha p using lighter (additive) blending. The two contributions
+	// always sum to one, which suits glowing or translucent images drawn onto
+	// a cleared target.
+	CrossfadeAdditive
+)
+
+// CrossfadeAlphas returns the alphas of the old and the new image at eased
+// progress p (0 shows only the old image, 1 only the new one). The ebitenfade
+// package turns them into color scales and draw options.
+func CrossfadeAlphas(p float32, blend CrossfadeBlend) (oldAlpha, newAlpha float32) {
+	p = min(max(p, 0), 1)
+	if blend == CrossfadeAdditive || p >= 1 {
+		return 1 - p, p
+	}
+	return 1, p
+}
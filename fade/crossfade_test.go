@@ -0,0 +1,29 @@
+package fade
+
+import (
+	"testing"
+)
+
+func TestCrossfadeAlphas(t *testing.T) {
+	for _, tc := range []struct {
+		p                float32
+		blend            CrossfadeBlend
+		wantOld, wantNew float32
+	}{
+		{0, CrossfadeNormal, 1, 0},
+		{0.5, CrossfadeNormal, 1, 0.5},
+		{1, CrossfadeNormal, 0, 1},
+		{-1, CrossfadeNormal, 1, 0},
+		{2, CrossfadeNormal, 0, 1},
+		{0, CrossfadeAdditive, 1, 0},
+		{0.5, CrossfadeAdditive, 0.5, 0.5},
+		{1, CrossfadeAdditive, 0, 1},
+		{-1, CrossfadeAdditive, 1, 0},
+		{2, CrossfadeAdditive, 0, 1},
+	} {
+		gotOld, gotNew := CrossfadeAlphas(tc.p, tc.blend)
+		if gotOld != tc.wantOld || gotNew != tc.wantNew {
+			t.Errorf("CrossfadeAlphas(%v, %v) = (%v, %v), want (%v, %v)", tc.p, tc.blend, gotOld, gotNew, tc.wantOld, tc.wantNew)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package fade
+
+import (
+	math "github.com/chewxy/math32"
+	optional "github.com/moznion/go-optional"
+)
+
+// AlphaCurve maps an eased alpha, read as perceived opacity, to the alpha to
+// draw with. A linear alpha ramp looks like it pops in at the start because
+// perceived brightness is nonlinear; GammaAlpha and PerceptualAlpha spread
+// the change evenly on screen.
+type AlphaCurve int
+
+const (
+	// LinearAlpha leaves alpha unchanged, overshoot included.
+	LinearAlpha AlphaCurve = iota
+	// GammaAlpha raises alpha to the power of 2.2.
+	GammaAlpha
+	// PerceptualAlpha treats alpha as CIE L* lightness and returns the
+	// matching relative luminance.
+	PerceptualAlpha
+)
+
+func (c AlphaCurve) String() string {
+	switch c {
+	case LinearAlpha:
+		return "linear"
+	case GammaAlpha:
+		return "gamma"
+	case PerceptualAlpha:
+		return "perceptual"
+	}
+	return "unknown"
+}
+
+// Apply maps alpha through the curve. Curves other than LinearAlpha clamp
+// alpha to [0, 1] first.
+func (c AlphaCurve) Apply(alpha float32) float32 {
+	if c == LinearAlpha {
+		return alpha
+	}
+	a := ClampAlpha(alpha)
+	switch c {
+	case GammaAlpha:
+		return math.Pow(a, 2.2)
+	case PerceptualAlpha:
+		l := a * 100
+		if l > 8 {
+			y := (l + 16) / 116
+			return y * y * y
+		}
+		return l / 903.3
+	}
+	return a
+}
+
+// WithCurve returns a copy of s whose Alpha is mapped through c. Delta and
+// Linear are left unchanged so that movement is not distorted.
+func (s Sample) WithCurve(c AlphaCurve) Sample {
+	s.Alpha = c.Apply(s.Alpha)
+	return s
+}
+
+// AlphaMoreCurve is AlphaMoreEase with an alpha response curve applied after
+// easing.
+func AlphaMoreCurve(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	fn func(alpha, rateEasing, rateTime float32, phase Phase),
+	curve AlphaCurve,
+	easeIn, easeOut Easer,
+) {
+	s := SampleMoreEase(t, fadeIn, static, fadeOut, easeIn, easeOut).WithCurve(curve)
+	fn(s.Alpha, s.RateEasing, s.RateTime, s.Phase)
+}
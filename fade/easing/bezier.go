@@ -0,0 +1,108 @@
+package easing
+
+import "math"
+
+/*  Cubic Bezier
+-----------------------------------------------*/
+
+// CubicBezier returns an easing function equivalent to the CSS
+// cubic-bezier(x1, y1, x2, y2) timing function. x1 and x2 are clamped to
+// [0, 1] as CSS requires; y1 and y2 may overshoot.
+func CubicBezier(x1, y1, x2, y2 float32) func(t float32) float32 {
+	if x1 < 0 {
+		x1 = 0
+	} else if x1 > 1 {
+		x1 = 1
+	}
+	if x2 < 0 {
+		x2 = 0
+	} else if x2 > 1 {
+		x2 = 1
+	}
+	if x1 == y1 && x2 == y2 {
+		return Linear
+	}
+	b := newUnitBezier(float64(x1), float64(y1), float64(x2), float64(y2))
+	return func(t float32) float32 {
+		if t <= 0 {
+			return 0
+		}
+		if t >= 1 {
+			return 1
+		}
+		return float32(b.sampleY(b.solveX(float64(t))))
+	}
+}
+
+// CSS named timing functions.
+var (
+	Ease      = CubicBezier(0.25, 0.1, 0.25, 1)
+	EaseIn    = CubicBezier(0.42, 0, 1, 1)
+	EaseOut   = CubicBezier(0, 0, 0.58, 1)
+	EaseInOut = CubicBezier(0.42, 0, 0.58, 1)
+)
+
+// unitBezier is a cubic bezier from (0, 0) to (1, 1) in polynomial form,
+// following the approach used by browser engines.
+type unitBezier struct {
+	ax, bx, cx float64
+	ay, by, cy float64
+}
+
+func newUnitBezier(x1, y1, x2, y2 float64) unitBezier {
+	var b unitBezier
+	b.cx = 3 * x1
+	b.bx = 3*(x2-x1) - b.cx
+	b.ax = 1 - b.cx - b.bx
+	b.cy = 3 * y1
+	b.by = 3*(y2-y1) - b.cy
+	b.ay = 1 - b.cy - b.by
+	return b
+}
+
+func (b unitBezier) sampleX(s float64) float64 {
+	return ((b.ax*s+b.bx)*s + b.cx) * s
+}
+
+func (b unitBezier) sampleY(s float64) float64 {
+	return ((b.ay*s+b.by)*s + b.cy) * s
+}
+
+func (b unitBezier) sampleDerivativeX(s float64) float64 {
+	return (3*b.ax*s+2*b.bx)*s + b.cx
+}
+
+const bezierEpsilon = 1e-7
+
+// solveX returns the curve parameter s for which sampleX(s) == x.
+// Newton's method is tried first and bisection is used as a fallback.
+func (b unitBezier) solveX(x float64) float64 {
+	s := x
+	for i := 0; i < 8; i++ {
+		dx := b.sampleX(s) - x
+		if math.Abs(dx) < bezierEpsilon {
+			return s
+		}
+		d := b.sampleDerivativeX(s)
+		if math.Abs(d) < 1e-6 {
+			break
+		}
+		s -= dx / d
+	}
+
+	lo, hi := 0.0, 1.0
+	s = x
+	for i := 0; i < 64 && lo < hi; i++ {
+		v := b.sampleX(s)
+		if math.Abs(v-x) < bezierEpsilon {
+			return s
+		}
+		if x > v {
+			lo = s
+		} else {
+			hi = s
+		}
+		s = (lo + hi) / 2
+	}
+	return s
+}
@@ -0,0 +1,79 @@
+package easing
+
+import (
+	"math"
+	"testing"
+)
+
+const bezierTolerance = 1e-4
+
+func TestCSSPresets(t *testing.T) {
+	// Reference values of the CSS timing functions, as computed by browsers.
+	xs := []float32{0.1, 0.25, 0.5, 0.75, 0.9}
+	for _, tc := range []struct {
+		name string
+		fn   func(float32) float32
+		want []float32
+	}{
+		{"ease", Ease, []float32{0.094796, 0.408511, 0.802403, 0.960459, 0.994316}},
+		{"ease-in", EaseIn, []float32{0.017027, 0.093465, 0.315357, 0.621862, 0.839428}},
+		{"ease-out", EaseOut, []float32{0.160572, 0.378138, 0.684643, 0.906535, 0.982973}},
+		{"ease-in-out", EaseInOut, []float32{0.019722, 0.129162, 0.5, 0.870838, 0.980278}},
+	} {
+		for i, x := range xs {
+			if got := tc.fn(x); math.Abs(float64(got-tc.want[i])) > bezierTolerance {
+				t.Errorf("%s(%v) = %v, want %v", tc.name, x, got, tc.want[i])
+			}
+		}
+	}
+}
+
+func TestCubicBezierEndpoints(t *testing.T) {
+	for _, fn := range []func(float32) float32{
+		Ease, EaseIn, EaseOut, EaseInOut,
+		CubicBezier(0.68, -0.55, 0.265, 1.55),
+	} {
+		for _, tc := range []struct{ x, want float32 }{
+			{-0.5, 0}, {0, 0}, {1, 1}, {1.5, 1},
+		} {
+			if got := fn(tc.x); got != tc.want {
+				t.Errorf("f(%v) = %v, want %v", tc.x, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestCubicBezierOvershoot(t *testing.T) {
+	// cubic-bezier(0.68, -0.55, 0.265, 1.55) dips below 0 and rises above 1.
+	fn := CubicBezier(0.68, -0.55, 0.265, 1.55)
+	for _, tc := range []struct{ x, want float32 }{
+		{0.1, -0.066291}, {0.25, -0.082807}, {0.5, 0.60668}, {0.75, 1.089166}, {0.9, 1.062373},
+	} {
+		if got := fn(tc.x); math.Abs(float64(got-tc.want)) > bezierTolerance {
+			t.Errorf("f(%v) = %v, want %v", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestCubicBezierClampsX(t *testing.T) {
+	got := CubicBezier(-1, 0, 2, 1)(0.3)
+	want := CubicBezier(0, 0, 1, 1)(0.3)
+	if got != want {
+		t.Errorf("x out of range: f(0.3) = %v, want %v", got, want)
+	}
+}
+
+func TestSolveXBisectionFallback(t *testing.T) {
+	// cubic-bezier(1, 0, 0, 1) has x'(s) = 3(2s-1)², which vanishes at s = 0.5,
+	// so Newton's method gives up near x = 0.5 and bisection takes over.
+	b := newUnitBezier(1, 0, 0, 1)
+	for _, x := range []float64{0.5001, 0.4999, 0.50025} {
+		if d := b.sampleDerivativeX(x); math.Abs(d) >= 1e-6 {
+			t.Fatalf("derivative at %v = %v, want a flat curve", x, d)
+		}
+		s := b.solveX(x)
+		if got := b.sampleX(s); math.Abs(got-x) > 1e-6 {
+			t.Errorf("sampleX(solveX(%v)) = %v", x, got)
+		}
+	}
+}
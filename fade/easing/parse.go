@@ -0,0 +1,158 @@
+package easing
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+/*  Parse
+-----------------------------------------------*/
+
+// named maps timing function names to easing functions. Penner families
+// are written "<family>-<in|out|in-out>"; CSS keywords are also accepted.
+var named = map[string]func(t float32) float32{
+	"linear": Linear,
+
+	"quad-in":     QuadEaseIn,
+	"quad-out":    QuadEaseOut,
+	"quad-in-out": QuadEaseInOut,
+
+	"cubic-in":     CubicEaseIn,
+	"cubic-out":    CubicEaseOut,
+	"cubic-in-out": CubicEaseInOut,
+
+	"quart-in":     QuartEaseIn,
+	"quart-out":    QuartEaseOut,
+	"quart-in-out": QuartEaseInOut,
+
+	"quint-in":     QuintEaseIn,
+	"quint-out":    QuintEaseOut,
+	"quint-in-out": QuintEaseInOut,
+
+	"sine-in":     SineEaseIn,
+	"sine-out":    SineEaseOut,
+	"sine-in-out": SineEaseInOut,
+
+	"expo-in":     ExpoEaseIn,
+	"expo-out":    ExpoEaseOut,
+	"expo-in-out": ExpoEaseInOut,
+
+	"circular-in":     CircularEaseIn,
+	"circular-out":    CircularEaseOut,
+	"circular-in-out": CircularEaseInOut,
+	"circ-in":         CircularEaseIn,
+	"circ-out":        CircularEaseOut,
+	"circ-in-out":     CircularEaseInOut,
+
+	"back-in":     BackEaseIn,
+	"back-out":    BackEaseOut,
+	"back-in-out": BackEaseInOut,
+
+	"elastic-in":     ElasticEaseIn,
+	"elastic-out":    ElasticEaseOut,
+	"elastic-in-out": ElasticEaseInOut,
+
+	"bounce-in":     BounceEaseIn,
+	"bounce-out":    BounceEaseOut,
+	"bounce-in-out": BounceEaseInOut,
+
+	"ease":        Ease,
+	"ease-in":     EaseIn,
+	"ease-out":    EaseOut,
+	"ease-in-out": EaseInOut,
+	"step-start":  Steps(1, JumpStart),
+	"step-end":    Steps(1, JumpEnd),
+}
+
+var stepPositions = map[string]StepPosition{
+	"jump-start": JumpStart,
+	"start":      JumpStart,
+	"jump-end":   JumpEnd,
+	"end":        JumpEnd,
+	"jump-none":  JumpNone,
+	"jump-both":  JumpBoth,
+}
+
+// Parse returns the easing function described by s. It accepts
+//
+//   - names such as "linear", "cubic-out" or "elastic-in-out"
+//   - CSS keywords: "ease", "ease-in", "ease-out", "ease-in-out",
+//     "step-start", "step-end"
+//   - "cubic-bezier(x1, y1, x2, y2)"
+//   - "steps(n)" and "steps(n, <jump-start|jump-end|jump-none|jump-both|start|end>)"
+//
+// Matching is case-insensitive and ignores surrounding whitespace.
+func Parse(s string) (func(t float32) float32, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	if fn, ok := named[name]; ok {
+		return fn, nil
+	}
+
+	if args, ok := callArgs(name, "cubic-bezier"); ok {
+		if len(args) != 4 {
+			return nil, fmt.Errorf("easing: cubic-bezier takes 4 arguments, got %d in %q", len(args), s)
+		}
+		var v [4]float32
+		for i, a := range args {
+			f, err := strconv.ParseFloat(a, 32)
+			if err != nil {
+				return nil, fmt.Errorf("easing: invalid cubic-bezier argument %q in %q", a, s)
+			}
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return nil, fmt.Errorf("easing: cubic-bezier argument %q is not a finite number in %q", a, s)
+			}
+			v[i] = float32(f)
+		}
+		if v[0] < 0 || v[0] > 1 || v[2] < 0 || v[2] > 1 {
+			return nil, fmt.Errorf("easing: cubic-bezier x values must be in [0, 1] in %q", s)
+		}
+		return CubicBezier(v[0], v[1], v[2], v[3]), nil
+	}
+
+	if args, ok := callArgs(name, "steps"); ok {
+		if len(args) < 1 || len(args) > 2 {
+			return nil, fmt.Errorf("easing: steps takes 1 or 2 arguments, got %d in %q", len(args), s)
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("easing: invalid step count %q in %q", args[0], s)
+		}
+		position := JumpEnd
+		if len(args) == 2 {
+			p, ok := stepPositions[args[1]]
+			if !ok {
+				return nil, fmt.Errorf("easing: unknown step position %q in %q", args[1], s)
+			}
+			position = p
+		}
+		if position == JumpNone && n < 2 {
+			return nil, fmt.Errorf("easing: steps with jump-none needs at least 2 steps in %q", s)
+		}
+		return Steps(n, position), nil
+	}
+
+	return nil, fmt.Errorf("easing: unknown timing function %q", s)
+}
+
+// callArgs splits "name(a, b, ...)" into its trimmed arguments.
+func callArgs(s, name string) ([]string, bool) {
+	rest, ok := strings.CutPrefix(s, name)
+	if !ok {
+		return nil, false
+	}
+	rest = strings.TrimSpace(rest)
+	if !strings.HasPrefix(rest, "(") || !strings.HasSuffix(rest, ")") {
+		return nil, false
+	}
+	rest = strings.TrimSpace(rest[1 : len(rest)-1])
+	if rest == "" {
+		return nil, true
+	}
+	args := strings.Split(rest, ",")
+	for i := range args {
+		args[i] = strings.TrimSpace(args[i])
+	}
+	return args, true
+}
@@ -0,0 +1,80 @@
+package easing
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want func(float32) float32
+	}{
+		{"linear", Linear},
+		{"cubic-out", CubicEaseOut},
+		{"elastic-in-out", ElasticEaseInOut},
+		{"circ-in", CircularEaseIn},
+		{"circular-in", CircularEaseIn},
+		{"  Bounce-Out\t", BounceEaseOut},
+		{"ease", Ease},
+		{"ease-in", EaseIn},
+		{"ease-out", EaseOut},
+		{"ease-in-out", EaseInOut},
+		{"EASE-IN-OUT", EaseInOut},
+		{"step-start", Steps(1, JumpStart)},
+		{"step-end", Steps(1, JumpEnd)},
+		{"cubic-bezier(.17,.67,.83,.67)", CubicBezier(0.17, 0.67, 0.83, 0.67)},
+		{"cubic-bezier( 0.68, -0.55, 0.265, 1.55 )", CubicBezier(0.68, -0.55, 0.265, 1.55)},
+		{"steps(4)", Steps(4, JumpEnd)},
+		{"steps(4, jump-end)", Steps(4, JumpEnd)},
+		{"steps(4, end)", Steps(4, JumpEnd)},
+		{"steps(4, jump-start)", Steps(4, JumpStart)},
+		{"steps(4, start)", Steps(4, JumpStart)},
+		{"steps(4, jump-none)", Steps(4, JumpNone)},
+		{"steps(4, jump-both)", Steps(4, JumpBoth)},
+	} {
+		fn, err := Parse(tc.in)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", tc.in, err)
+			continue
+		}
+		for _, x := range []float32{0, 0.1, 0.3, 0.5, 0.7, 0.9, 1} {
+			if got, want := fn(x), tc.want(x); got != want {
+				t.Errorf("Parse(%q)(%v) = %v, want %v", tc.in, x, got, want)
+			}
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tc := range []struct {
+		in      string
+		wantErr string
+	}{
+		{"", `easing: unknown timing function ""`},
+		{"wobble", `easing: unknown timing function "wobble"`},
+		{"cubic-sideways", `easing: unknown timing function "cubic-sideways"`},
+		{"cubic-bezier(0, 0, 1)", `easing: cubic-bezier takes 4 arguments, got 3 in "cubic-bezier(0, 0, 1)"`},
+		{"cubic-bezier()", `easing: cubic-bezier takes 4 arguments, got 0 in "cubic-bezier()"`},
+		{"cubic-bezier(a, 0, 1, 1)", `easing: invalid cubic-bezier argument "a" in "cubic-bezier(a, 0, 1, 1)"`},
+		{"cubic-bezier(1.5, 0, 1, 1)", `easing: cubic-bezier x values must be in [0, 1] in "cubic-bezier(1.5, 0, 1, 1)"`},
+		{"cubic-bezier(0, 0, -0.1, 1)", `easing: cubic-bezier x values must be in [0, 1] in "cubic-bezier(0, 0, -0.1, 1)"`},
+		{"cubic-bezier(nan, 0, 1, 1)", `easing: cubic-bezier argument "nan" is not a finite number in "cubic-bezier(nan, 0, 1, 1)"`},
+		{"cubic-bezier(0,inf,1,1)", `easing: cubic-bezier argument "inf" is not a finite number in "cubic-bezier(0,inf,1,1)"`},
+		{"cubic-bezier(0, 0, 1, -Inf)", `easing: cubic-bezier argument "-inf" is not a finite number in "cubic-bezier(0, 0, 1, -Inf)"`},
+		{"steps()", `easing: steps takes 1 or 2 arguments, got 0 in "steps()"`},
+		{"steps(1, end, start)", `easing: steps takes 1 or 2 arguments, got 3 in "steps(1, end, start)"`},
+		{"steps(0)", `easing: invalid step count "0" in "steps(0)"`},
+		{"steps(x)", `easing: invalid step count "x" in "steps(x)"`},
+		{"steps(4, middle)", `easing: unknown step position "middle" in "steps(4, middle)"`},
+		{"steps(1, jump-none)", `easing: steps with jump-none needs at least 2 steps in "steps(1, jump-none)"`},
+	} {
+		_, err := Parse(tc.in)
+		if err == nil {
+			t.Errorf("Parse(%q) succeeded, want error %q", tc.in, tc.wantErr)
+			continue
+		}
+		if err.Error() != tc.wantErr {
+			t.Errorf("Parse(%q) error = %q, want %q", tc.in, err, tc.wantErr)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package easing
+
+import (
+	math "github.com/chewxy/math32"
+)
+
+/*  Steps
+-----------------------------------------------*/
+
+// StepPosition selects where the jumps of a Steps easing happen,
+// matching the CSS <step-position> keywords.
+type StepPosition int
+
+const (
+	JumpEnd StepPosition = iota
+	JumpStart
+	JumpNone
+	JumpBoth
+)
+
+// Steps returns an easing function equivalent to the CSS steps(n, position)
+// timing function. n is raised to the minimum the position allows
+// (1, or 2 for JumpNone).
+func Steps(n int, position StepPosition) func(t float32) float32 {
+	if n < 1 {
+		n = 1
+	}
+	if position == JumpNone && n < 2 {
+		n = 2
+	}
+	jumps := n
+	switch position {
+	case JumpNone:
+		jumps = n - 1
+	case JumpBoth:
+		jumps = n + 1
+	}
+	return func(t float32) float32 {
+		if t >= 1 {
+			return 1
+		}
+		step := int(math.Floor(t * float32(n)))
+		if position == JumpStart || position == JumpBoth {
+			step++
+		}
+		if step < 0 {
+			step = 0
+		}
+		if step > jumps {
+			step = jumps
+		}
+		return float32(step) / float32(jumps)
+	}
+}
@@ -0,0 +1,47 @@
+package ebitenfade
+
+import (
+	"github.com/funatsufumiya/ebiten_fade/fade"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// CrossfadeScales returns the color scales of the old and the new image at
+// eased progress p. Color scales apply to premultiplied colors, so the alpha
+// scales all four components.
+func CrossfadeScales(p float32, blend fade.CrossfadeBlend) (oldScale, newScale ebiten.ColorScale) {
+	oldAlpha, newAlpha := fade.CrossfadeAlphas(p, blend)
+	oldScale.ScaleAlpha(oldAlpha)
+	newScale.ScaleAlpha(newAlpha)
+	return oldScale, newScale
+}
+
+// CrossfadeBlendMode returns the ebiten blend to draw both images with.
+func CrossfadeBlendMode(blend fade.CrossfadeBlend) ebiten.Blend {
+	if blend == fade.CrossfadeAdditive {
+		return ebiten.BlendLighter
+	}
+	return ebiten.BlendSourceOver
+}
+
+// CrossfadeOptions returns the draw options of the old and the new image at
+// eased progress p, both starting from geoM.
+func CrossfadeOptions(p float32, blend fade.CrossfadeBlend, geoM ebiten.GeoM) (oldOp, newOp *ebiten.DrawImageOptions) {
+	oldScale, newScale := CrossfadeScales(p, blend)
+	mode := CrossfadeBlendMode(blend)
+	oldOp = &ebiten.DrawImageOptions{GeoM: geoM, ColorScale: oldScale, Blend: mode}
+	newOp = &ebiten.DrawImageOptions{GeoM: geoM, ColorScale: newScale, Blend: mode}
+	return oldOp, newOp
+}
+
+// DrawCrossfade draws from and to onto dst at eased progress p. Images with
+// zero alpha are skipped.
+func DrawCrossfade(dst, from, to *ebiten.Image, p float32, blend fade.CrossfadeBlend, geoM ebiten.GeoM) {
+	oldAlpha, newAlpha := fade.CrossfadeAlphas(p, blend)
+	oldOp, newOp := CrossfadeOptions(p, blend, geoM)
+	if from != nil && oldAlpha > 0 {
+		dst.DrawImage(from, oldOp)
+	}
+	if to != nil && newAlpha > 0 {
+		dst.DrawImage(to, newOp)
+	}
+}
@@ -0,0 +1,56 @@
+package ebitenfade
+
+import (
+	"testing"
+
+	"github.com/funatsufumiya/ebiten_fade/fade"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestCrossfadeScales(t *testing.T) {
+	for _, blend := range []fade.CrossfadeBlend{fade.CrossfadeNormal, fade.CrossfadeAdditive} {
+		for _, p := range []float32{-1, 0, 0.5, 1, 2} {
+			wantOld, wantNew := fade.CrossfadeAlphas(p, blend)
+			oldScale, newScale := CrossfadeScales(p, blend)
+			for _, s := range []struct {
+				name  string
+				scale ebiten.ColorScale
+				want  float32
+			}{
+				{"old", oldScale, wantOld},
+				{"new", newScale, wantNew},
+			} {
+				c := s.scale
+				if c.R() != s.want || c.G() != s.want || c.B() != s.want || c.A() != s.want {
+					t.Errorf("CrossfadeScales(%v, %v) %s = (%v, %v, %v, %v), want all %v", p, blend, s.name, c.R(), c.G(), c.B(), c.A(), s.want)
+				}
+			}
+		}
+	}
+}
+
+func TestCrossfadeOptions(t *testing.T) {
+	var geoM ebiten.GeoM
+	geoM.Translate(3, 4)
+	for _, tc := range []struct {
+		blend fade.CrossfadeBlend
+		mode  ebiten.Blend
+	}{
+		{fade.CrossfadeNormal, ebiten.BlendSourceOver},
+		{fade.CrossfadeAdditive, ebiten.BlendLighter},
+	} {
+		if got := CrossfadeBlendMode(tc.blend); got != tc.mode {
+			t.Errorf("CrossfadeBlendMode(%v) = %v, want %v", tc.blend, got, tc.mode)
+		}
+		oldOp, newOp := CrossfadeOptions(0.25, tc.blend, geoM)
+		oldScale, newScale := CrossfadeScales(0.25, tc.blend)
+		for _, op := range []*ebiten.DrawImageOptions{oldOp, newOp} {
+			if op.Blend != tc.mode || op.GeoM != geoM {
+				t.Errorf("CrossfadeOptions(0.25, %v) blend or geoM = %v, %v", tc.blend, op.Blend, op.GeoM)
+			}
+		}
+		if oldOp.ColorScale != oldScale || newOp.ColorScale != newScale {
+			t.Errorf("CrossfadeOptions(0.25, %v) color scales differ from CrossfadeScales", tc.blend)
+		}
+	}
+}
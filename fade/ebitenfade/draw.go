@@ -0,0 +1,42 @@
+package ebitenfade
+
+import (
+	"github.com/funatsufumiya/ebiten_fade/fade"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// AlphaColorScale returns a color scale that applies the clamped alpha.
+func AlphaColorScale(alpha float32) ebiten.ColorScale {
+	var cs ebiten.ColorScale
+	cs.ScaleAlpha(fade.ClampAlpha(alpha))
+	return cs
+}
+
+// ScaleAlpha multiplies cs by the clamped alpha. ColorScale is premultiplied,
+// so all four components are scaled.
+func ScaleAlpha(cs *ebiten.ColorScale, alpha float32) {
+	cs.ScaleAlpha(fade.ClampAlpha(alpha))
+}
+
+// ApplyAlpha multiplies the color scale of op by the clamped alpha.
+func ApplyAlpha(op *ebiten.DrawImageOptions, alpha float32) {
+	ScaleAlpha(&op.ColorScale, alpha)
+}
+
+// ApplyAlphaVertices multiplies the colors of vertices by the clamped alpha,
+// honoring the ColorScaleMode of op: straight-alpha vertex colors only have
+// their alpha scaled, premultiplied ones have all four components scaled.
+// A nil op means the default, straight alpha.
+func ApplyAlphaVertices(vertices []ebiten.Vertex, op *ebiten.DrawTrianglesOptions, alpha float32) {
+	a := fade.ClampAlpha(alpha)
+	premultiplied := op != nil && op.ColorScaleMode == ebiten.ColorScaleModePremultipliedAlpha
+	for i := range vertices {
+		v := &vertices[i]
+		if premultiplied {
+			v.ColorR *= a
+			v.ColorG *= a
+			v.ColorB *= a
+		}
+		v.ColorA *= a
+	}
+}
@@ -0,0 +1,52 @@
+package ebitenfade
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestAlphaColorScale(t *testing.T) {
+	for _, tc := range []struct{ in, want float32 }{
+		{-0.5, 0}, {0.25, 0.25}, {1.5, 1},
+	} {
+		cs := AlphaColorScale(tc.in)
+		if cs.R() != tc.want || cs.G() != tc.want || cs.B() != tc.want || cs.A() != tc.want {
+			t.Errorf("AlphaColorScale(%v) = %v, want all %v", tc.in, cs, tc.want)
+		}
+	}
+
+	op := &ebiten.DrawImageOptions{}
+	op.ColorScale.Scale(1, 0.5, 1, 1)
+	ApplyAlpha(op, 0.5)
+	if cs := op.ColorScale; cs.R() != 0.5 || cs.G() != 0.25 || cs.B() != 0.5 || cs.A() != 0.5 {
+		t.Errorf("ApplyAlpha(0.5) = %v, want (0.5, 0.25, 0.5, 0.5)", cs)
+	}
+}
+
+func TestApplyAlphaVertices(t *testing.T) {
+	vertex := ebiten.Vertex{ColorR: 1, ColorG: 0.5, ColorB: 0.25, ColorA: 1}
+	for _, tc := range []struct {
+		name string
+		op   *ebiten.DrawTrianglesOptions
+		want ebiten.Vertex
+	}{
+		{"nil", nil, ebiten.Vertex{ColorR: 1, ColorG: 0.5, ColorB: 0.25, ColorA: 0.5}},
+		{"straight", &ebiten.DrawTrianglesOptions{ColorScaleMode: ebiten.ColorScaleModeStraightAlpha}, ebiten.Vertex{ColorR: 1, ColorG: 0.5, ColorB: 0.25, ColorA: 0.5}},
+		{"premultiplied", &ebiten.DrawTrianglesOptions{ColorScaleMode: ebiten.ColorScaleModePremultipliedAlpha}, ebiten.Vertex{ColorR: 0.5, ColorG: 0.25, ColorB: 0.125, ColorA: 0.5}},
+	} {
+		vs := []ebiten.Vertex{vertex, vertex}
+		ApplyAlphaVertices(vs, tc.op, 0.5)
+		for _, v := range vs {
+			if v != tc.want {
+				t.Errorf("%s: ApplyAlphaVertices(0.5) = %+v, want %+v", tc.name, v, tc.want)
+			}
+		}
+	}
+
+	vs := []ebiten.Vertex{vertex}
+	ApplyAlphaVertices(vs, nil, 1.7)
+	if vs[0] != vertex {
+		t.Errorf("ApplyAlphaVertices(1.7) = %+v, want unchanged %+v", vs[0], vertex)
+	}
+}
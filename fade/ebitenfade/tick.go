@@ -0,0 +1,42 @@
+// Package ebitenfade connects package fade to ebiten. Package fade itself does
+// not import ebiten, so that it builds and tests without cgo or a display.
+package ebitenfade
+
+import (
+	"github.com/funatsufumiya/ebiten_fade/fade"
+	"github.com/hajimehoshi/ebiten/v2"
+	optional "github.com/moznion/go-optional"
+)
+
+// TPS returns ebiten's current ticks per second. With ebiten.SyncWithFPS it
+// returns the actual frame rate, or ebiten.DefaultTPS before one is known.
+func TPS() float64 {
+	tps := ebiten.TPS()
+	if tps == ebiten.SyncWithFPS {
+		if fps := ebiten.ActualFPS(); fps > 0 {
+			return fps
+		}
+		tps = ebiten.DefaultTPS
+	}
+	if tps <= 0 {
+		tps = ebiten.DefaultTPS
+	}
+	return float64(tps)
+}
+
+// WithTPS makes tick-driven faders advance by 1/TPS() seconds per Update.
+func WithTPS() fade.FaderOption {
+	return fade.WithTPS(TPS)
+}
+
+// NewTickInteractiveFader returns a fade.TickInteractiveFader that follows
+// ebiten's tick rate.
+func NewTickInteractiveFader(fadeInSec float32, fadeOutSec optional.Option[float32], opts ...fade.FaderOption) *fade.TickInteractiveFader {
+	return fade.NewTickInteractiveFader(fadeInSec, fadeOutSec, append([]fade.FaderOption{WithTPS()}, opts...)...)
+}
+
+// NewTickNonInteractiveFader returns a fade.TickNonInteractiveFader that
+// follows ebiten's tick rate.
+func NewTickNonInteractiveFader(fadeInSec, staticSec float32, fadeOutSec optional.Option[float32], opts ...fade.FaderOption) *fade.TickNonInteractiveFader {
+	return fade.NewTickNonInteractiveFader(fadeInSec, staticSec, fadeOutSec, append([]fade.FaderOption{WithTPS()}, opts...)...)
+}
@@ -1,7 +1,7 @@
 package fade
 
 import (
-	"time"
+	"math"
 
 	"github.com/funatsufumiya/ebiten_fade/fade/easing"
 	optional "github.com/moznion/go-optional"
@@ -9,6 +9,7 @@ import (
 
 // EasingFunction represents the type of easing function.
 type EasingFunction int
+
 const (
 	Linear EasingFunction = iota
 	Quad
@@ -25,6 +26,7 @@ const (
 
 // EasingType represents the direction/type of easing.
 type EasingType int
+
 const (
 	In EasingType = iota
 	Out
@@ -33,12 +35,27 @@ const (
 
 // Phase represents the fade phase.
 type Phase int
+
 const (
 	FadeIn Phase = iota
 	Static
 	FadeOut
+	// Delay is reported while a fader or offset fade waits to start.
+	Delay
 )
 
+// Easer maps linear progress in [0, 1] to eased progress.
+// Any func(float32) float32 from the easing package can be used as an Easer.
+type Easer func(t float32) float32
+
+// ease applies e to t, treating a nil Easer as linear.
+func (e Easer) ease(t float32) float32 {
+	if e == nil {
+		return t
+	}
+	return e(t)
+}
+
 // Use optional.Optional[float32] for optional fadeOut
 
 // Advanced applies a fade effect with full control (rateEasing, rateTime, phase) and custom callback.
@@ -48,17 +65,29 @@ func Advanced(
 	fn func(rateEasing, rateTime float32, phase Phase),
 	easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType,
 ) {
+	AdvancedEase(t, fadeIn, static, fadeOut, fn, NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+// AdvancedEase is Advanced with arbitrary Easers for fade-in and fade-out.
+// A nil Easer is treated as linear.
+func AdvancedEase(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	fn func(rateEasing, rateTime float32, phase Phase),
+	easeIn, easeOut Easer,
+) {
+	rateEasing, rateTime, phase := advanced(t, fadeIn, static, fadeOut, easeIn, easeOut)
+	fn(rateEasing, rateTime, phase)
+}
+
+// advanced computes what Advanced passes to its callback.
+func advanced(t, fadeIn, static float32, fadeOut optional.Option[float32], easeIn, easeOut Easer) (rateEasing, rateTime float32, phase Phase) {
 	total := fadeIn + static
 	if fadeOut.IsSome() {
 		v, _ := fadeOut.Take()
 		total += v
 	}
 
-	var (
-		rateEasing, rateTime float32
-		phase Phase
-	)
-
 	switch {
 	case t < 0:
 		rateEasing = 0
@@ -67,7 +96,7 @@ func Advanced(
 	case t < fadeIn:
 		rateTime = t / fadeIn
 		phase = FadeIn
-		rateEasing = applyEasing(easingFuncIn, easingTypeIn, rateTime)
+		rateEasing = easeIn.ease(rateTime)
 	case t < fadeIn+static:
 		rateTime = 1
 		rateEasing = 1
@@ -76,20 +105,18 @@ func Advanced(
 		v, _ := fadeOut.Take()
 		rateTime = (t - fadeIn - static) / v
 		phase = FadeOut
-		rateEasing = applyEasing(easingFuncOut, easingTypeOut, rateTime)
+		rateEasing = easeOut.ease(rateTime)
 	default:
 		rateEasing = 1
 		rateTime = 1
 		phase = FadeOut
 	}
-	fn(rateEasing, rateTime, phase)
+	return rateEasing, rateTime, phase
 }
 
 // Alpha applies a simple fade-in/out effect.
 func Alpha(t, fadeIn, static, fadeOut float32, fn func(alpha float32)) {
-	AlphaMore(t, fadeIn, static, optional.Some[float32](fadeOut), func(alpha, _rateEasing, _rateTime float32, _phase Phase) {
-		fn(alpha)
-	}, Linear, Out, Linear, Out)
+	fn(AlphaValue(t, fadeIn, static, fadeOut))
 }
 
 // AlphaMore applies a fade effect with more control and callback details.
@@ -99,19 +126,22 @@ func AlphaMore(
 	fn func(alpha, rateEasing, rateTime float32, phase Phase),
 	easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType,
 ) {
-	Advanced(t, fadeIn, static, fadeOut, func(rateEasing, rateTime float32, phase Phase) {
-		var alpha float32
-		switch phase {
-		case FadeIn:
-			alpha = rateEasing
-		case Static:
-			alpha = 1
-		case FadeOut:
-			alpha = 1 - rateEasing
-		}
-		fn(alpha, rateEasing, rateTime, phase)
-	}, easingFuncIn, easingTypeIn, easingFuncOut, easingTypeOut)
+	AlphaMoreEase(t, fadeIn, static, fadeOut, fn, NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+// AlphaMoreEase is AlphaMore with arbitrary Easers for fade-in and fade-out.
+func AlphaMoreEase(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	fn func(alpha, rateEasing, rateTime float32, phase Phase),
+	easeIn, easeOut Easer,
+) {
+	s := SampleMoreEase(t, fadeIn, static, fadeOut, easeIn, easeOut)
+	fn(s.Alpha, s.RateEasing, s.RateTime, s.Phase)
+}
 
+func (p Phase) String() string {
+	return PhaseToString(p)
 }
 
 func PhaseToString(p Phase) string {
@@ -122,114 +152,114 @@ func PhaseToString(p Phase) string {
 		return "Static"
 	case FadeOut:
 		return "FadeOut"
+	case Delay:
+		return "Delay"
 	}
 	return "Unknown"
 }
 
-// applyEasing is a stub for go-easing function call. Replace with actual go-easing usage.
-func applyEasing(funcType EasingFunction, typeType EasingType, t float32) float32 {
+// NewEaser returns the Easer for an EasingFunction and EasingType pair.
+func NewEaser(funcType EasingFunction, typeType EasingType) Easer {
 	switch funcType {
 	case Linear:
-		return t
+		return easing.Linear
 	case Quad:
 		switch typeType {
 		case In:
-			return easing.QuadEaseIn(t)
+			return easing.QuadEaseIn
 		case Out:
-			return easing.QuadEaseOut(t)
+			return easing.QuadEaseOut
 		case InOut:
-			return easing.QuadEaseInOut(t)
+			return easing.QuadEaseInOut
 		}
 	case Cubic:
 		switch typeType {
 		case In:
-			return easing.CubicEaseIn(t)
+			return easing.CubicEaseIn
 		case Out:
-			return easing.CubicEaseOut(t)
+			return easing.CubicEaseOut
 		case InOut:
-			return easing.CubicEaseInOut(t)
+			return easing.CubicEaseInOut
 		}
 	case Quart:
 		switch typeType {
 		case In:
-			return easing.QuartEaseIn(t)
+			return easing.QuartEaseIn
 		case Out:
-			return easing.QuartEaseOut(t)
+			return easing.QuartEaseOut
 		case InOut:
-			return easing.QuartEaseInOut(t)
+			return easing.QuartEaseInOut
 		}
 	case Quint:
 		switch typeType {
 		case In:
-			return easing.QuintEaseIn(t)
+			return easing.QuintEaseIn
 		case Out:
-			return easing.QuintEaseOut(t)
+			return easing.QuintEaseOut
 		case InOut:
-			return easing.QuintEaseInOut(t)
+			return easing.QuintEaseInOut
 		}
 	case Sine:
 		switch typeType {
 		case In:
-			return easing.SineEaseIn(t)
+			return easing.SineEaseIn
 		case Out:
-			return easing.SineEaseOut(t)
+			return easing.SineEaseOut
 		case InOut:
-			return easing.SineEaseInOut(t)
+			return easing.SineEaseInOut
 		}
 	case Expo:
 		switch typeType {
 		case In:
-			return easing.ExpoEaseIn(t)
+			return easing.ExpoEaseIn
 		case Out:
-			return easing.ExpoEaseOut(t)
+			return easing.ExpoEaseOut
 		case InOut:
-			return easing.ExpoEaseInOut(t)
+			return easing.ExpoEaseInOut
 		}
 	case Circular:
 		switch typeType {
 		case In:
-			return easing.CircularEaseIn(t)
+			return easing.CircularEaseIn
 		case Out:
-			return easing.CircularEaseOut(t)
+			return easing.CircularEaseOut
 		case InOut:
-			return easing.CircularEaseInOut(t)
+			return easing.CircularEaseInOut
 		}
 	case Back:
 		switch typeType {
 		case In:
-			return easing.BackEaseIn(t)
+			return easing.BackEaseIn
 		case Out:
-			return easing.BackEaseOut(t)
+			return easing.BackEaseOut
 		case InOut:
-			return easing.BackEaseInOut(t)
+			return easing.BackEaseInOut
 		}
 	case Elastic:
 		switch typeType {
 		case In:
-			return easing.ElasticEaseIn(t)
+			return easing.ElasticEaseIn
 		case Out:
-			return easing.ElasticEaseOut(t)
+			return easing.ElasticEaseOut
 		case InOut:
-			return easing.ElasticEaseInOut(t)
+			return easing.ElasticEaseInOut
 		}
 	case Bounce:
 		switch typeType {
 		case In:
-			return easing.BounceEaseIn(t)
+			return easing.BounceEaseIn
 		case Out:
-			return easing.BounceEaseOut(t)
+			return easing.BounceEaseOut
 		case InOut:
-			return easing.BounceEaseInOut(t)
+			return easing.BounceEaseInOut
 		}
 	}
-	return t // fallback: linear
+	return easing.Linear // fallback: linear
 }
 
 // Delta applies a simple delta fade effect.
 func Delta(t, fadeIn, static, fadeOut, delta float32, fn func(delta float32)) {
-	DeltaMore(t, fadeIn, static, optional.Some[float32](fadeOut), delta, func(deltaVal, _alpha, _rateEasing, _rateTime float32, _phase Phase) {
-		fn(deltaVal)
-	}, Linear, Out, Linear, Out)
+	fn(DeltaValue(t, fadeIn, static, fadeOut, delta))
 }
 
 // DeltaMore applies a delta fade effect with more control and callback details.
@@ -240,21 +270,19 @@ func DeltaMore(
 	fn func(delta, alpha, rateEasing, rateTime float32, phase Phase),
 	easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType,
 ) {
-	Advanced(t, fadeIn, static, fadeOut, func(rateEasing, rateTime float32, phase Phase) {
-		var alpha, deltaVal float32
-		switch phase {
-		case FadeIn:
-			alpha = rateEasing
-			deltaVal = rateEasing * delta
-		case Static:
-			alpha = 1
-			deltaVal = delta
-		case FadeOut:
-			alpha = 1 - rateEasing
-			deltaVal = alpha * delta
-		}
-		fn(deltaVal, alpha, rateEasing, rateTime, phase)
-	}, easingFuncIn, easingTypeIn, easingFuncOut, easingTypeOut)
+	DeltaMoreEase(t, fadeIn, static, fadeOut, delta, fn, NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+// DeltaMoreEase is DeltaMore with arbitrary Easers for fade-in and fade-out.
+func DeltaMoreEase(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	delta float32,
+	fn func(delta, alpha, rateEasing, rateTime float32, phase Phase),
+	easeIn, easeOut Easer,
+) {
+	s := SampleMoreEase(t, fadeIn, static, fadeOut, easeIn, easeOut).WithDelta(delta)
+	fn(s.Delta, s.Alpha, s.RateEasing, s.RateTime, s.Phase)
 }
 
 // --- Fader types ---
@@ -262,54 +290,147 @@ func (f *InteractiveFader) Stop() {
 	f.started = false
 	f.startTime = optional.None[float64]()
 	f.fadeoutStartedTime = optional.None[float64]()
+	f.phases.stop()
 }
 
 // InteractiveFader provides fade-in/fade-out timer for interactive usage.
 type InteractiveFader struct {
-	FadeInSec  float32
-	FadeOutSec optional.Option[float32]
-	started    bool
-	startTime  optional.Option[float64]
+	FadeInSec          float32
+	FadeOutSec         optional.Option[float32]
+	DelaySec           float32
+	PreRollSec         float32
+	AlphaCurve         AlphaCurve
+	started            bool
+	startTime          optional.Option[float64]
 	fadeoutStartedTime optional.Option[float64]
+	time               localClock
+	phases             phaseTracker
+	reversals          int
 }
 
-func NewInteractiveFader(fadeInSec float32, fadeOutSec optional.Option[float32]) *InteractiveFader {
+func NewInteractiveFader(fadeInSec float32, fadeOutSec optional.Option[float32], opts ...FaderOption) *InteractiveFader {
+	o := newFaderOptions(opts)
 	return &InteractiveFader{
 		FadeInSec:  fadeInSec,
 		FadeOutSec: fadeOutSec,
+		DelaySec:   o.delay,
+		PreRollSec: o.preRoll,
+		AlphaCurve: o.alphaCurve,
+		time:       newLocalClock(o.clock),
 	}
 }
 
+// now returns the fader's local time in seconds, which stops while paused
+// and runs at the fader's time scale.
+func (f *InteractiveFader) now() float64 {
+	return f.time.now()
+}
+
+// Pause freezes the fader at its current state.
+func (f *InteractiveFader) Pause() {
+	f.time.pause()
+}
+
+// Resume continues a paused fader from where it was paused.
+func (f *InteractiveFader) Resume() {
+	f.time.resume()
+}
+
+func (f *InteractiveFader) IsPaused() bool {
+	return f.time.paused
+}
+
+// SetTimeScale sets how fast the fader runs relative to its clock
+// (1 is normal speed, 0.5 is slow motion, 2 is fast-forward).
+// Negative values are treated as 0.
+func (f *InteractiveFader) SetTimeScale(scale float32) {
+	f.time.setScale(scale)
+}
+
+func (f *InteractiveFader) TimeScale() float32 {
+	return f.time.scale
+}
+
+// Start starts fading in after DelaySec, skipping the first PreRollSec seconds.
 func (f *InteractiveFader) Start() {
 	f.started = true
-	f.startTime = optional.Some[float64](float64(time.Now().UnixNano()) / 1e9)
+	f.startTime = optional.Some[float64](f.now() + float64(f.DelaySec-f.PreRollSec))
 	f.fadeoutStartedTime = optional.None[float64]()
+	f.reversals = 0
+	f.phases.reset(f.ordinal())
 }
 
 func (f *InteractiveFader) FadeOut(immediate bool) {
-       if !f.started || !f.FadeOutSec.IsSome() {
-	       return
-       }
-       now := float64(time.Now().UnixNano()) / 1e9
-       if !f.startTime.IsSome() {
-	       f.Start()
-       }
-       f.fadeoutStartedTime = optional.Some[float64](now)
-       if immediate {
-		startValRaw, _ := f.startTime.Value()
-		startVal := startValRaw.(float64)
+	if !f.started || !f.FadeOutSec.IsSome() {
+		return
+	}
+	// report transitions under the old timing before changing it
+	generation := f.phases.generation
+	f.dispatch()
+	if f.phases.generation != generation {
+		// a callback restarted or stopped the fader
+		return
+	}
+	now := f.now()
+	if !f.startTime.IsSome() {
+		f.Start()
+	}
+	f.fadeoutStartedTime = optional.Some[float64](now)
+	if immediate {
+		startVal := f.startTime.Unwrap()
 		elapsed := now - startVal
-	       if elapsed < float64(f.FadeInSec) {
-		       diffIn := float64(f.FadeInSec) - elapsed
-		       diffInRate := diffIn / float64(f.FadeInSec)
-		       fadeOutVal, _ := f.FadeOutSec.Take()
-		       diffOut := diffInRate * float64(fadeOutVal)
-					   f.startTime = optional.Some[float64](startVal - (diffIn + diffOut))
-					   fadeoutValRaw, _ := f.fadeoutStartedTime.Value()
-					   fadeoutVal := fadeoutValRaw.(float64)
-					   f.fadeoutStartedTime = optional.Some[float64](fadeoutVal - (diffIn + diffOut))
-	       }
-       }
+		if elapsed < 0 {
+			// still in the delay; fade out from a fade-in that just began
+			startVal, elapsed = now, 0
+		}
+		if elapsed < float64(f.FadeInSec) {
+			diffIn := float64(f.FadeInSec) - elapsed
+			diffInRate := diffIn / float64(f.FadeInSec)
+			diffOut := diffInRate * float64(f.FadeOutSec.Unwrap())
+			f.startTime = optional.Some[float64](startVal - (diffIn + diffOut))
+			f.fadeoutStartedTime = optional.Some[float64](now - (diffIn + diffOut))
+		}
+	}
+}
+
+// FadeIn reverses an in-progress fade-out, fading back in from the current
+// alpha so the value stays continuous (exactly so with linear easing).
+// A fade-out that has been requested but has not progressed yet is simply
+// cancelled. After the fade-out has finished it fades in from zero; before
+// Start it behaves like Start. It does nothing while fading in or static.
+func (f *InteractiveFader) FadeIn() {
+	if !f.started || !f.startTime.IsSome() {
+		f.Start()
+		return
+	}
+	if !f.fadeoutStartedTime.IsSome() {
+		return
+	}
+	now := f.now()
+	fadeOutStart := max(f.fadeoutStartedTime.Unwrap(), f.startTime.Unwrap()+float64(f.FadeInSec))
+	progress := now - fadeOutStart
+	if progress <= 0 {
+		// Cancelling changes nothing up to now, and there is no fade-out
+		// to reverse, so no phase is entered or left.
+		f.fadeoutStartedTime = optional.None[float64]()
+		return
+	}
+	rate := float32(1)
+	if fadeOut := f.FadeOutSec.Unwrap(); fadeOut > 0 {
+		rate = min(float32(progress)/fadeOut, 1)
+	}
+
+	// report the fade-out under the old timing before reversing it
+	generation := f.phases.generation
+	f.dispatch()
+	if f.phases.generation != generation {
+		// a callback restarted or stopped the fader
+		return
+	}
+	f.startTime = optional.Some[float64](now - float64((1-rate)*f.FadeInSec))
+	f.fadeoutStartedTime = optional.None[float64]()
+	f.reversals++
+	f.phases.finished = false
 }
 
 func (f *InteractiveFader) IsStarted() bool {
@@ -321,109 +442,62 @@ func (f *InteractiveFader) IsFadeOutStarted() bool {
 }
 
 func (f *InteractiveFader) IsFinished() bool {
-       if !f.started || !f.startTime.IsSome() {
-	       return false
-       }
-	startValRaw, _ := f.startTime.Value()
-	startVal := startValRaw.(float64)
-	elapsed := float32(float64(time.Now().UnixNano())/1e9 - startVal)
-       if f.fadeoutStartedTime.IsSome() && f.FadeOutSec.IsSome() {
-	       fadeOutVal, _ := f.FadeOutSec.Take()
-	       return elapsed > f.FadeInSec + float32(fadeOutVal)
-       }
-       return false
+	f.dispatch()
+	return f.finished()
+}
+
+func (f *InteractiveFader) finished() bool {
+	if !f.started || !f.fadeoutStartedTime.IsSome() {
+		return false
+	}
+	t, staticSec, fadeOut, ok := f.timing()
+	return ok && t > f.FadeInSec+staticSec+fadeOut
+}
+
+// timing returns the elapsed time, static duration and fade-out duration
+// to feed into the stateless helpers. Until FadeOut is called the static
+// phase lasts forever. ok is false if the fader has not started.
+func (f *InteractiveFader) timing() (t, staticSec, fadeOut float32, ok bool) {
+	if !f.startTime.IsSome() {
+		return 0, 0, 0, false
+	}
+	startVal := f.startTime.Unwrap()
+	t = float32(f.now() - startVal)
+	staticSec = float32(math.Inf(1))
+	if f.fadeoutStartedTime.IsSome() && f.FadeOutSec.IsSome() {
+		staticSec = float32(f.fadeoutStartedTime.Unwrap() - startVal - float64(f.FadeInSec))
+		if staticSec < 0 {
+			staticSec = 0
+		}
+		fadeOut = f.FadeOutSec.Unwrap()
+	}
+	return t, staticSec, fadeOut, true
 }
 
 func (f *InteractiveFader) Alpha(fn func(alpha float32)) {
-       if !f.startTime.IsSome() {
-	       fn(0)
-	       return
-       }
-	startValRaw, _ := f.startTime.Value()
-	startVal := startValRaw.(float64)
-	t := float32(float64(time.Now().UnixNano())/1e9 - startVal)
-       var staticSec float32 = 0
-       var fadeOut float32 = 0
-       if f.fadeoutStartedTime.IsSome() && f.FadeOutSec.IsSome() {
-	       fadeOutVal, _ := f.FadeOutSec.Take()
-			   fadeoutValRaw, _ := f.fadeoutStartedTime.Value()
-			   fadeoutVal := fadeoutValRaw.(float64)
-			   staticSec = float32(fadeoutVal - startVal - float64(f.FadeInSec))
-	       if staticSec < 0 {
-		       staticSec = 0
-	       }
-	       fadeOut = fadeOutVal
-       }
-       Alpha(t, f.FadeInSec, staticSec, fadeOut, fn)
+	fn(f.AlphaValue())
 }
 
 func (f *InteractiveFader) AlphaMore(fn func(alpha, rateEasing, rateTime float32, phase Phase), easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType) {
-       if !f.startTime.IsSome() {
-	       fn(0, 0, 0, FadeIn)
-	       return
-       }
-	startValRaw, _ := f.startTime.Value()
-	startVal := startValRaw.(float64)
-	t := float32(float64(time.Now().UnixNano())/1e9 - startVal)
-       var staticSec float32 = 0
-       var fadeOut float32 = 0
-       if f.fadeoutStartedTime.IsSome() && f.FadeOutSec.IsSome() {
-	       fadeOutVal, _ := f.FadeOutSec.Take()
-			   fadeoutValRaw, _ := f.fadeoutStartedTime.Value()
-			   fadeoutVal := fadeoutValRaw.(float64)
-			   staticSec = float32(fadeoutVal - startVal - float64(f.FadeInSec))
-	       if staticSec < 0 {
-		       staticSec = 0
-	       }
-	       fadeOut = fadeOutVal
-       }
-       AlphaMore(t, f.FadeInSec, staticSec, optional.Some[float32](fadeOut), fn, easingFuncIn, easingTypeIn, easingFuncOut, easingTypeOut)
+	f.AlphaMoreEase(fn, NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+func (f *InteractiveFader) AlphaMoreEase(fn func(alpha, rateEasing, rateTime float32, phase Phase), easeIn, easeOut Easer) {
+	s := f.SampleMoreEase(easeIn, easeOut)
+	fn(s.Alpha, s.RateEasing, s.RateTime, s.Phase)
 }
 
 func (f *InteractiveFader) Delta(delta float32, fn func(delta float32)) {
-       if !f.startTime.IsSome() {
-	       fn(0)
-	       return
-       }
-	startValRaw, _ := f.startTime.Value()
-	startVal := startValRaw.(float64)
-	t := float32(float64(time.Now().UnixNano())/1e9 - startVal)
-       var staticSec float32 = 0
-       var fadeOut float32 = 0
-       if f.fadeoutStartedTime.IsSome() && f.FadeOutSec.IsSome() {
-	       fadeOutVal, _ := f.FadeOutSec.Take()
-			   fadeoutValRaw, _ := f.fadeoutStartedTime.Value()
-			   fadeoutVal := fadeoutValRaw.(float64)
-			   staticSec = float32(fadeoutVal - startVal - float64(f.FadeInSec))
-	       if staticSec < 0 {
-		       staticSec = 0
-	       }
-	       fadeOut = fadeOutVal
-       }
-       Delta(t, f.FadeInSec, staticSec, fadeOut, delta, fn)
+	fn(f.DeltaValue(delta))
 }
 
 func (f *InteractiveFader) DeltaMore(delta float32, fn func(delta, alpha, rateEasing, rateTime float32, phase Phase), easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType) {
-       if !f.startTime.IsSome() {
-	       fn(0, 0, 0, 0, FadeIn)
-	       return
-       }
-	startValRaw, _ := f.startTime.Value()
-	startVal := startValRaw.(float64)
-	t := float32(float64(time.Now().UnixNano())/1e9 - startVal)
-       var staticSec float32 = 0
-       var fadeOut float32 = 0
-       if f.fadeoutStartedTime.IsSome() && f.FadeOutSec.IsSome() {
-	       fadeOutVal, _ := f.FadeOutSec.Take()
-			   fadeoutValRaw, _ := f.fadeoutStartedTime.Value()
-			   fadeoutVal := fadeoutValRaw.(float64)
-			   staticSec = float32(fadeoutVal - startVal - float64(f.FadeInSec))
-	       if staticSec < 0 {
-		       staticSec = 0
-	       }
-	       fadeOut = fadeOutVal
-       }
-       DeltaMore(t, f.FadeInSec, staticSec, optional.Some[float32](fadeOut), delta, fn, easingFuncIn, easingTypeIn, easingFuncOut, easingTypeOut)
+	f.DeltaMoreEase(delta, fn, NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+func (f *InteractiveFader) DeltaMoreEase(delta float32, fn func(delta, alpha, rateEasing, rateTime float32, phase Phase), easeIn, easeOut Easer) {
+	s := f.SampleMoreEase(easeIn, easeOut).WithDelta(delta)
+	fn(s.Delta, s.Alpha, s.RateEasing, s.RateTime, s.Phase)
 }
 
 // NonInteractiveFader provides fade-in/static/fade-out timer for non-interactive usage.
@@ -431,21 +505,175 @@ type NonInteractiveFader struct {
 	FadeInSec  float32
 	StaticSec  float32
 	FadeOutSec optional.Option[float32]
+	DelaySec   float32
+	PreRollSec float32
+	AlphaCurve AlphaCurve
 	started    bool
-	startTime  time.Time
+	startTime  float64
+	time       localClock
+	loopMode   LoopMode
+	phases     phaseTracker
 }
 
-func NewNonInteractiveFader(fadeInSec, staticSec float32, fadeOutSec optional.Option[float32]) *NonInteractiveFader {
+func NewNonInteractiveFader(fadeInSec, staticSec float32, fadeOutSec optional.Option[float32], opts ...FaderOption) *NonInteractiveFader {
+	o := newFaderOptions(opts)
 	return &NonInteractiveFader{
 		FadeInSec:  fadeInSec,
 		StaticSec:  staticSec,
 		FadeOutSec: fadeOutSec,
+		DelaySec:   o.delay,
+		PreRollSec: o.preRoll,
+		AlphaCurve: o.alphaCurve,
+		time:       newLocalClock(o.clock),
+		loopMode:   o.loopMode,
+	}
+}
+
+// now returns the fader's local time in seconds, which stops while paused
+// and runs at the fader's time scale.
+func (f *NonInteractiveFader) now() float64 {
+	return f.time.now()
+}
+
+// Pause freezes the fader at its current state.
+func (f *NonInteractiveFader) Pause() {
+	f.time.pause()
+}
+
+// Resume continues a paused fader from where it was paused.
+func (f *NonInteractiveFader) Resume() {
+	f.time.resume()
+}
+
+func (f *NonInteractiveFader) IsPaused() bool {
+	return f.time.paused
+}
+
+// SetTimeScale sets how fast the fader runs relative to its clock
+// (1 is normal speed, 0.5 is slow motion, 2 is fast-forward).
+// Negative values are treated as 0.
+func (f *NonInteractiveFader) SetTimeScale(scale float32) {
+	f.time.setScale(scale)
+}
+
+func (f *NonInteractiveFader) TimeScale() float32 {
+	return f.time.scale
+}
+
+// elapsed returns the seconds since Start, or 0 if not started.
+func (f *NonInteractiveFader) elapsed() float32 {
+	if !f.started {
+		return 0
+	}
+	return float32(f.now() - f.startTime)
+}
+
+func (f *NonInteractiveFader) fadeOutSec() float32 {
+	if f.FadeOutSec.IsSome() {
+		return f.FadeOutSec.Unwrap()
+	}
+	return 0
+}
+
+// period returns the length of a single iteration.
+func (f *NonInteractiveFader) period() float32 {
+	return f.FadeInSec + f.StaticSec + f.fadeOutSec()
+}
+
+// playTime returns the time on the fade's own timeline: elapsed shifted by
+// PreRollSec and DelaySec, negative while delayed. It is 0 before Start.
+func (f *NonInteractiveFader) playTime() float32 {
+	if !f.started {
+		return 0
+	}
+	return f.elapsed() + f.PreRollSec - f.DelaySec
+}
+
+// localTime returns the time within the current iteration and the iteration number.
+func (f *NonInteractiveFader) localTime() (float32, int) {
+	return f.loopMode.Apply(f.playTime(), f.period())
+}
+
+// SetLoopMode changes how the fader repeats. See LoopMode.
+func (f *NonInteractiveFader) SetLoopMode(m LoopMode) {
+	f.loopMode = m
+}
+
+func (f *NonInteractiveFader) LoopMode() LoopMode {
+	return f.loopMode
+}
+
+// Iteration returns the zero-based number of the iteration currently playing.
+func (f *NonInteractiveFader) Iteration() int {
+	_, iteration := f.localTime()
+	return iteration
+}
+
+// Duration returns the length of the whole playback in seconds including
+// the delay, or of a single iteration if the loop mode never finishes.
+func (f *NonInteractiveFader) Duration() float32 {
+	if n := f.loopMode.Iterations(); n > 0 {
+		return max(f.DelaySec-f.PreRollSec+f.period()*float32(n), 0)
 	}
+	return f.period()
+}
+
+// Elapsed returns the seconds played since Start, or 0 if not started.
+func (f *NonInteractiveFader) Elapsed() float32 {
+	return f.elapsed()
+}
+
+// Progress returns how far playback is, from 0 to 1, or 0 if not started.
+// For Loop and PingPong it is the progress within the current iteration.
+func (f *NonInteractiveFader) Progress() float32 {
+	if !f.started {
+		return 0
+	}
+	d := f.Duration()
+	if d <= 0 {
+		return 1
+	}
+	t := f.Elapsed()
+	if f.loopMode.Iterations() == 0 {
+		t = f.playTime()
+		_, iteration := f.loopMode.Apply(t, d)
+		t -= float32(iteration) * d
+	}
+	return min(max(t/d, 0), 1)
+}
+
+// Seek jumps to sec seconds after start, starting the fader if needed.
+// A paused fader stays paused at the new position.
+func (f *NonInteractiveFader) Seek(sec float32) {
+	if sec < 0 {
+		sec = 0
+	}
+	f.started = true
+	f.startTime = f.now() - float64(sec)
+	f.phases.reset(f.ordinal())
+	f.phases.finished = f.finished()
+}
+
+// SeekProgress jumps to progress p (0 to 1) of Duration. For Loop and
+// PingPong it seeks within the current iteration.
+func (f *NonInteractiveFader) SeekProgress(p float32) {
+	p = min(max(p, 0), 1)
+	d := f.Duration()
+	if f.loopMode.Iterations() > 0 {
+		f.Seek(p * d)
+		return
+	}
+	var iteration int
+	if f.started {
+		iteration = f.Iteration()
+	}
+	f.Seek((float32(iteration)+p)*d - f.PreRollSec + f.DelaySec)
 }
 
 func (f *NonInteractiveFader) Start() {
 	f.started = true
-	f.startTime = time.Now()
+	f.startTime = f.now()
+	f.phases.reset(f.ordinal())
 }
 
 func (f *NonInteractiveFader) IsStarted() bool {
@@ -453,63 +681,39 @@ func (f *NonInteractiveFader) IsStarted() bool {
 }
 
 func (f *NonInteractiveFader) IsFinished() bool {
+	f.dispatch()
+	return f.finished()
+}
+
+func (f *NonInteractiveFader) finished() bool {
 	if !f.started {
 		return false
 	}
-	total := f.FadeInSec + f.StaticSec
-	if f.FadeOutSec.IsSome() {
-		v, _ := f.FadeOutSec.Take()
-		total += v
-	}
-	return float32(time.Since(f.startTime).Seconds()) > total
+	return f.loopMode.Finished(f.playTime(), f.period())
 }
 
 func (f *NonInteractiveFader) Delta(delta float32, fn func(delta float32)) {
-	t := float32(time.Since(f.startTime).Seconds())
-	var fadeOut float32
-	if f.FadeOutSec.IsSome() {
-		v, _ := f.FadeOutSec.Take()
-		fadeOut = v
-	} else {
-		fadeOut = 0
-	}
-	Delta(t, f.FadeInSec, f.StaticSec, fadeOut, delta, func(d float32) {
-		fn(d)
-	})
+	fn(f.DeltaValue(delta))
 }
 
 func (f *NonInteractiveFader) DeltaMore(delta float32, fn func(delta, alpha, rateEasing, rateTime float32, phase Phase), easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType) {
-	t := float32(time.Since(f.startTime).Seconds())
-	var fadeOut float32
-	if f.FadeOutSec.IsSome() {
-		v, _ := f.FadeOutSec.Take()
-		fadeOut = v
-	} else {
-		fadeOut = 0
-	}
-	DeltaMore(t, f.FadeInSec, f.StaticSec, optional.Some[float32](fadeOut), delta, fn, easingFuncIn, easingTypeIn, easingFuncOut, easingTypeOut)
+	f.DeltaMoreEase(delta, fn, NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+func (f *NonInteractiveFader) DeltaMoreEase(delta float32, fn func(delta, alpha, rateEasing, rateTime float32, phase Phase), easeIn, easeOut Easer) {
+	s := f.SampleMoreEase(easeIn, easeOut).WithDelta(delta)
+	fn(s.Delta, s.Alpha, s.RateEasing, s.RateTime, s.Phase)
 }
 
 func (f *NonInteractiveFader) Alpha(fn func(alpha float32)) {
-	t := float32(time.Since(f.startTime).Seconds())
-	var fadeOut float32
-	if f.FadeOutSec.IsSome() {
-		v, _ := f.FadeOutSec.Take()
-		fadeOut = v
-	} else {
-		fadeOut = 0
-	}
-	Alpha(t, f.FadeInSec, f.StaticSec, fadeOut, fn)
+	fn(f.AlphaValue())
 }
 
 func (f *NonInteractiveFader) AlphaMore(fn func(alpha, rateEasing, rateTime float32, phase Phase), easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType) {
-	t := float32(time.Since(f.startTime).Seconds())
-	var fadeOut float32
-	if f.FadeOutSec.IsSome() {
-		v, _ := f.FadeOutSec.Take()
-		fadeOut = v
-	} else {
-		fadeOut = 0
-	}
-	AlphaMore(t, f.FadeInSec, f.StaticSec, optional.Some[float32](fadeOut), fn, easingFuncIn, easingTypeIn, easingFuncOut, easingTypeOut)
+	f.AlphaMoreEase(fn, NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+func (f *NonInteractiveFader) AlphaMoreEase(fn func(alpha, rateEasing, rateTime float32, phase Phase), easeIn, easeOut Easer) {
+	s := f.SampleMoreEase(easeIn, easeOut)
+	fn(s.Alpha, s.RateEasing, s.RateTime, s.Phase)
 }
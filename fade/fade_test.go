@@ -0,0 +1,257 @@
+package fade
+
+import (
+	"testing"
+
+	optional "github.com/moznion/go-optional"
+)
+
+const epsilon = 1e-4
+
+func approx(a, b float32) bool {
+	d := a - b
+	return d < epsilon && d > -epsilon
+}
+
+func alphaOf(f interface{ Alpha(func(float32)) }) float32 {
+	var a float32
+	f.Alpha(func(alpha float32) { a = alpha })
+	return a
+}
+
+// Without a FadeOut call the fader used to drop to alpha 0 as soon as the
+// fade-in ended, because the static phase had zero length.
+func TestInteractiveFaderStaysStaticUntilFadeOut(t *testing.T) {
+	clock := NewManualClock()
+	f := NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock))
+	f.Start()
+
+	for _, sec := range []float64{1.5, 10, 100} {
+		clock.Set(0)
+		clock.AdvanceSeconds(sec)
+		if a := alphaOf(f); !approx(a, 1) {
+			t.Errorf("alpha at %vs without FadeOut = %v, want 1", sec, a)
+		}
+		if f.IsFinished() {
+			t.Errorf("IsFinished at %vs without FadeOut = true, want false", sec)
+		}
+	}
+}
+
+// IsFinished used to compare the elapsed time with fade-in + fade-out only,
+// ignoring the time spent static before FadeOut was called.
+func TestInteractiveFaderIsFinishedCountsStaticTime(t *testing.T) {
+	clock := NewManualClock()
+	f := NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock))
+	f.Start()
+	clock.AdvanceSeconds(3)
+	f.FadeOut(false)
+
+	clock.AdvanceSeconds(0.5)
+	if f.IsFinished() {
+		t.Fatal("IsFinished halfway through the fade-out = true, want false")
+	}
+	if a := alphaOf(f); !approx(a, 0.5) {
+		t.Errorf("alpha halfway through the fade-out = %v, want 0.5", a)
+	}
+	clock.AdvanceSeconds(0.6)
+	if !f.IsFinished() {
+		t.Error("IsFinished after the fade-out = false, want true")
+	}
+}
+
+func TestInteractiveFaderWithManualClock(t *testing.T) {
+	clock := NewManualClock()
+	f := NewInteractiveFader(0.5, optional.Some[float32](1), WithClock(clock))
+	if f.IsStarted() || f.IsFinished() {
+		t.Fatal("new fader reports started or finished")
+	}
+	if a := alphaOf(f); a != 0 {
+		t.Errorf("alpha before Start = %v, want 0", a)
+	}
+
+	f.Start()
+	clock.AdvanceSeconds(0.25)
+	if a := alphaOf(f); !approx(a, 0.5) {
+		t.Errorf("alpha at 0.25s = %v, want 0.5", a)
+	}
+	clock.AdvanceSeconds(0.75)
+	if a := alphaOf(f); !approx(a, 1) {
+		t.Errorf("alpha at 1s = %v, want 1", a)
+	}
+
+	f.FadeOut(false)
+	if !f.IsFadeOutStarted() {
+		t.Fatal("IsFadeOutStarted after FadeOut = false")
+	}
+	clock.AdvanceSeconds(0.25)
+	var gotDelta, gotAlpha float32
+	var gotPhase Phase
+	f.DeltaMore(100, func(delta, alpha, rateEasing, rateTime float32, phase Phase) {
+		gotDelta, gotAlpha, gotPhase = delta, alpha, phase
+	}, Linear, In, Linear, In)
+	if !approx(gotDelta, 75) || !approx(gotAlpha, 0.75) || gotPhase != FadeOut {
+		t.Errorf("DeltaMore 0.25s into the fade-out = (%v, %v, %v), want (75, 0.75, FadeOut)", gotDelta, gotAlpha, gotPhase)
+	}
+	if f.IsFinished() {
+		t.Error("IsFinished during the fade-out = true")
+	}
+
+	clock.AdvanceSeconds(1)
+	if !f.IsFinished() {
+		t.Error("IsFinished after the fade-out = false")
+	}
+	if a := alphaOf(f); a != 0 {
+		t.Errorf("alpha after the fade-out = %v, want 0", a)
+	}
+}
+
+func TestNonInteractiveFaderWithManualClock(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 2, optional.Some[float32](1), WithClock(clock))
+	f.Start()
+
+	for _, tc := range []struct {
+		sec      float64
+		alpha    float32
+		phase    Phase
+		finished bool
+	}{
+		{0.5, 0.5, FadeIn, false},
+		{1.5, 1, Static, false},
+		{3.5, 0.5, FadeOut, false},
+		{4.5, 0, FadeOut, true},
+	} {
+		clock.Set(0)
+		clock.AdvanceSeconds(tc.sec)
+		if a := alphaOf(f); !approx(a, tc.alpha) {
+			t.Errorf("alpha at %vs = %v, want %v", tc.sec, a, tc.alpha)
+		}
+		var gotDelta float32
+		var gotPhase Phase
+		f.DeltaMore(10, func(delta, alpha, rateEasing, rateTime float32, phase Phase) {
+			gotDelta, gotPhase = delta, phase
+		}, Linear, In, Linear, In)
+		if !approx(gotDelta, tc.alpha*10) || gotPhase != tc.phase {
+			t.Errorf("DeltaMore at %vs = (%v, %v), want (%v, %v)", tc.sec, gotDelta, gotPhase, tc.alpha*10, tc.phase)
+		}
+		if got := f.IsFinished(); got != tc.finished {
+			t.Errorf("IsFinished at %vs = %v, want %v", tc.sec, got, tc.finished)
+		}
+	}
+}
+
+func TestInteractiveFaderToggleContinuity(t *testing.T) {
+	clock := NewManualClock()
+	f := NewInteractiveFader(1, optional.Some[float32](2), WithClock(clock))
+	f.Start()
+
+	// Toggle at uneven intervals: mid fade-in, mid fade-out, while static
+	// and after the fade-out has finished.
+	for i, step := range []struct {
+		sec   float64
+		alpha float32
+	}{
+		{0.4, 0.4}, {0.3, 0.25}, {0.9, 1}, {0.25, 0.875}, {1.5, 1},
+		{0.6, 0.7}, {0.1, 0.8}, {2.5, 0}, {0.7, 0.7},
+	} {
+		clock.AdvanceSeconds(step.sec)
+		before := alphaOf(f)
+		if !approx(before, step.alpha) {
+			t.Errorf("toggle %d: alpha = %v, want %v", i, before, step.alpha)
+		}
+		if f.IsFadeOutStarted() {
+			f.FadeIn()
+		} else {
+			f.FadeOut(true)
+		}
+		if after := alphaOf(f); !approx(after, before) {
+			t.Errorf("toggle %d: alpha jumped from %v to %v", i, before, after)
+		}
+	}
+}
+
+func TestNonInteractiveFaderUnstartedProgress(t *testing.T) {
+	for name, mode := range map[string]LoopMode{"Once": Once, "Loop": Loop, "PingPong": PingPong, "Repeat(2)": Repeat(2)} {
+		f := NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(NewManualClock()), WithLoopMode(mode))
+		if e, p, i := f.Elapsed(), f.Progress(), f.Iteration(); e != 0 || p != 0 || i != 0 {
+			t.Errorf("%s before Start: Elapsed, Progress, Iteration = %v, %v, %v; want 0, 0, 0", name, e, p, i)
+		}
+		if f.IsFinished() {
+			t.Errorf("%s before Start: IsFinished = true", name)
+		}
+	}
+}
+
+func TestNonInteractiveFaderSeek(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock))
+
+	f.Seek(1.5)
+	if !f.IsStarted() || f.Elapsed() != 1.5 || !approx(f.Progress(), 0.5) || f.Phase() != Static {
+		t.Errorf("Seek(1.5): started %v, Elapsed %v, Progress %v, Phase %v", f.IsStarted(), f.Elapsed(), f.Progress(), f.Phase())
+	}
+	clock.AdvanceSeconds(0.75)
+	if e, a := f.Elapsed(), alphaOf(f); !approx(e, 2.25) || !approx(a, 0.75) {
+		t.Errorf("0.75s after Seek(1.5): Elapsed, alpha = %v, %v; want 2.25, 0.75", e, a)
+	}
+	f.SeekProgress(0.25)
+	if e, a := f.Elapsed(), alphaOf(f); !approx(e, 0.75) || !approx(a, 0.75) || f.Phase() != FadeIn {
+		t.Errorf("SeekProgress(0.25): Elapsed, alpha, Phase = %v, %v, %v; want 0.75, 0.75, FadeIn", e, a, f.Phase())
+	}
+	f.Seek(-1)
+	if e := f.Elapsed(); e != 0 {
+		t.Errorf("Seek(-1): Elapsed = %v, want 0", e)
+	}
+
+	// A paused fader shows the seek position at once and stays there.
+	f.Pause()
+	f.Seek(2.5)
+	if a := alphaOf(f); !approx(a, 0.5) {
+		t.Errorf("paused Seek(2.5): alpha = %v, want 0.5", a)
+	}
+	clock.AdvanceSeconds(1)
+	if e := f.Elapsed(); !approx(e, 2.5) {
+		t.Errorf("paused after Seek(2.5): Elapsed = %v, want 2.5", e)
+	}
+	f.Resume()
+	clock.AdvanceSeconds(0.25)
+	if e, a := f.Elapsed(), alphaOf(f); !approx(e, 2.75) || !approx(a, 0.25) {
+		t.Errorf("resumed: Elapsed, alpha = %v, %v; want 2.75, 0.25", e, a)
+	}
+	clock.AdvanceSeconds(0.5)
+	if !f.IsFinished() || f.Progress() != 1 {
+		t.Errorf("after the end: IsFinished, Progress = %v, %v; want true, 1", f.IsFinished(), f.Progress())
+	}
+}
+
+func TestNonInteractiveFaderSeekLooped(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock), WithLoopMode(Loop))
+	f.Seek(7.5)
+	if i, p, a := f.Iteration(), f.Progress(), alphaOf(f); i != 2 || !approx(p, 0.5) || a != 1 {
+		t.Errorf("Loop Seek(7.5): Iteration, Progress, alpha = %v, %v, %v; want 2, 0.5, 1", i, p, a)
+	}
+	// SeekProgress stays within the current iteration.
+	f.SeekProgress(0.25)
+	if i, e, p := f.Iteration(), f.Elapsed(), f.Progress(); i != 2 || !approx(e, 6.75) || !approx(p, 0.25) {
+		t.Errorf("Loop SeekProgress(0.25): Iteration, Elapsed, Progress = %v, %v, %v; want 2, 6.75, 0.25", i, e, p)
+	}
+
+	f = NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock), WithLoopMode(Repeat(2)), WithDelay(1))
+	if d := f.Duration(); d != 7 {
+		t.Fatalf("Repeat(2) with a delay: Duration = %v, want 7", d)
+	}
+	f.SeekProgress(0.5)
+	if i, e, a := f.Iteration(), f.Elapsed(), alphaOf(f); i != 0 || !approx(e, 3.5) || !approx(a, 0.5) {
+		t.Errorf("Repeat(2) SeekProgress(0.5): Iteration, Elapsed, alpha = %v, %v, %v; want 0, 3.5, 0.5", i, e, a)
+	}
+	f.SeekProgress(1)
+	if f.IsFinished() || f.Iteration() != 1 {
+		t.Errorf("Repeat(2) at the very end: IsFinished, Iteration = %v, %v; want false, 1", f.IsFinished(), f.Iteration())
+	}
+	clock.AdvanceSeconds(0.1)
+	if !f.IsFinished() || f.Progress() != 1 {
+		t.Errorf("Repeat(2) after the end: IsFinished, Progress = %v, %v; want true, 1", f.IsFinished(), f.Progress())
+	}
+}
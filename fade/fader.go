@@ -0,0 +1,49 @@
+package fade
+
+// Fader is the method set shared by InteractiveFader, NonInteractiveFader and
+// their tick-driven variants. Helpers that only need to start, query or
+// sample a fade accept a Fader.
+type Fader interface {
+	Start()
+	IsStarted() bool
+	IsFinished() bool
+	Phase() Phase
+	// Update fires pending callbacks; tick-driven faders also advance time.
+	Update()
+
+	Alpha(fn func(alpha float32))
+	AlphaMore(fn func(alpha, rateEasing, rateTime float32, phase Phase), easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType)
+	AlphaMoreEase(fn func(alpha, rateEasing, rateTime float32, phase Phase), easeIn, easeOut Easer)
+	Delta(delta float32, fn func(delta float32))
+	DeltaMore(delta float32, fn func(delta, alpha, rateEasing, rateTime float32, phase Phase), easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType)
+	DeltaMoreEase(delta float32, fn func(delta, alpha, rateEasing, rateTime float32, phase Phase), easeIn, easeOut Easer)
+
+	AlphaValue() float32
+	DeltaValue(delta float32) float32
+	SampleMore(easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType) Sample
+	SampleMoreEase(easeIn, easeOut Easer) Sample
+}
+
+// Pausable is implemented by faders that can be paused and time-scaled.
+type Pausable interface {
+	Pause()
+	Resume()
+	IsPaused() bool
+	SetTimeScale(scale float32)
+}
+
+// FadeOuter is implemented by faders whose fade-out is triggered on demand.
+type FadeOuter interface {
+	FadeOut(immediate bool)
+	IsFadeOutStarted() bool
+}
+
+var (
+	_ Fader = (*InteractiveFader)(nil)
+	_ Fader = (*NonInteractiveFader)(nil)
+
+	_ Pausable = (*InteractiveFader)(nil)
+	_ Pausable = (*NonInteractiveFader)(nil)
+
+	_ FadeOuter = (*InteractiveFader)(nil)
+)
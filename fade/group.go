@@ -0,0 +1,171 @@
+package fade
+
+import "slices"
+
+// FaderGroup controls many faders, and nested groups, as one.
+type FaderGroup struct {
+	// RemoveFinished drops faders from the group on Update once they finish.
+	RemoveFinished bool
+
+	faders []Fader
+	groups []*FaderGroup
+}
+
+func NewFaderGroup(faders ...Fader) *FaderGroup {
+	g := &FaderGroup{}
+	g.Add(faders...)
+	return g
+}
+
+// Add appends faders to the group.
+func (g *FaderGroup) Add(faders ...Fader) {
+	g.faders = append(g.faders, faders...)
+}
+
+// AddGroup nests groups inside g. Every *All method and query recurses into them.
+func (g *FaderGroup) AddGroup(groups ...*FaderGroup) {
+	g.groups = append(g.groups, groups...)
+}
+
+// Remove removes f from the group (not from nested groups) and reports
+// whether it was present.
+func (g *FaderGroup) Remove(f Fader) bool {
+	i := slices.Index(g.faders, f)
+	if i < 0 {
+		return false
+	}
+	g.faders = slices.Delete(g.faders, i, i+1)
+	return true
+}
+
+// RemoveGroup removes a nested group and reports whether it was present.
+func (g *FaderGroup) RemoveGroup(child *FaderGroup) bool {
+	i := slices.Index(g.groups, child)
+	if i < 0 {
+		return false
+	}
+	g.groups = slices.Delete(g.groups, i, i+1)
+	return true
+}
+
+// Faders returns the faders directly owned by g.
+func (g *FaderGroup) Faders() []Fader {
+	return g.faders
+}
+
+// Groups returns the groups nested directly in g.
+func (g *FaderGroup) Groups() []*FaderGroup {
+	return g.groups
+}
+
+// Len returns the number of faders in g and all nested groups.
+func (g *FaderGroup) Len() int {
+	n := len(g.faders)
+	for _, c := range g.groups {
+		n += c.Len()
+	}
+	return n
+}
+
+// each calls fn for every fader in g and its nested groups.
+func (g *FaderGroup) each(fn func(f Fader)) {
+	for _, f := range g.faders {
+		fn(f)
+	}
+	for _, c := range g.groups {
+		c.each(fn)
+	}
+}
+
+// Update updates every fader (advancing tick-driven ones), then removes
+// finished ones if RemoveFinished is set.
+func (g *FaderGroup) Update() {
+	for _, f := range g.faders {
+		f.Update()
+	}
+	if g.RemoveFinished {
+		g.faders = slices.DeleteFunc(g.faders, Fader.IsFinished)
+	}
+	for _, c := range g.groups {
+		c.Update()
+	}
+}
+
+func (g *FaderGroup) StartAll() {
+	g.each(Fader.Start)
+}
+
+// FadeOutAll calls FadeOut on every fader that supports it.
+func (g *FaderGroup) FadeOutAll(immediate bool) {
+	g.each(func(f Fader) {
+		if fo, ok := f.(FadeOuter); ok {
+			fo.FadeOut(immediate)
+		}
+	})
+}
+
+// FadeInAll calls FadeIn on every fader that supports it.
+func (g *FaderGroup) FadeInAll() {
+	g.each(func(f Fader) {
+		if fi, ok := f.(interface{ FadeIn() }); ok {
+			fi.FadeIn()
+		}
+	})
+}
+
+// PauseAll pauses every fader that supports it.
+func (g *FaderGroup) PauseAll() {
+	g.each(func(f Fader) {
+		if p, ok := f.(Pausable); ok {
+			p.Pause()
+		}
+	})
+}
+
+// ResumeAll resumes every fader that supports it.
+func (g *FaderGroup) ResumeAll() {
+	g.each(func(f Fader) {
+		if p, ok := f.(Pausable); ok {
+			p.Resume()
+		}
+	})
+}
+
+// SetTimeScaleAll sets the time scale of every fader that supports it.
+func (g *FaderGroup) SetTimeScaleAll(scale float32) {
+	g.each(func(f Fader) {
+		if p, ok := f.(Pausable); ok {
+			p.SetTimeScale(scale)
+		}
+	})
+}
+
+// AllFinished reports whether no fader in the group is left unfinished.
+func (g *FaderGroup) AllFinished() bool {
+	for _, f := range g.faders {
+		if !f.IsFinished() {
+			return false
+		}
+	}
+	for _, c := range g.groups {
+		if !c.AllFinished() {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyActive reports whether any fader has started and not yet finished.
+func (g *FaderGroup) AnyActive() bool {
+	for _, f := range g.faders {
+		if f.IsStarted() && !f.IsFinished() {
+			return true
+		}
+	}
+	for _, c := range g.groups {
+		if c.AnyActive() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package fade
+
+import (
+	"testing"
+
+	optional "github.com/moznion/go-optional"
+)
+
+func TestFaderGroup(t *testing.T) {
+	clock := NewManualClock()
+	a := NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock))
+	b := NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock))
+	c := NewNonInteractiveFader(0.5, 0, optional.Some[float32](0.5), WithClock(clock))
+	d := NewTickNonInteractiveFader(1, 0, optional.Some[float32](0), WithTPS(func() float64 { return 2 }))
+
+	child := NewFaderGroup(c, d)
+	child.RemoveFinished = true
+	g := NewFaderGroup(a, b)
+	g.AddGroup(child)
+	if g.Len() != 4 || g.AllFinished() || g.AnyActive() {
+		t.Fatalf("before StartAll: Len, AllFinished, AnyActive = %v, %v, %v; want 4, false, false", g.Len(), g.AllFinished(), g.AnyActive())
+	}
+
+	g.StartAll()
+	for i, f := range []Fader{a, b, c, d} {
+		if !f.IsStarted() {
+			t.Errorf("fader %d not started by StartAll", i)
+		}
+	}
+	if !g.AnyActive() {
+		t.Error("AnyActive after StartAll = false")
+	}
+
+	// Paused faders, including nested tick-driven ones, do not move.
+	g.PauseAll()
+	clock.AdvanceSeconds(5)
+	g.Update()
+	for i, f := range []Fader{a, b, c, d} {
+		if alpha := alphaOf(f); alpha != 0 {
+			t.Errorf("paused fader %d: alpha = %v, want 0", i, alpha)
+		}
+	}
+	g.ResumeAll()
+
+	// At double speed c finishes and its group drops it on Update; d needs
+	// two ticks to get past its end.
+	g.SetTimeScaleAll(2)
+	clock.AdvanceSeconds(0.6)
+	g.Update()
+	if len(child.Faders()) != 1 || child.Faders()[0] != Fader(d) || g.Len() != 3 {
+		t.Errorf("after c finished: child faders %v, Len %v; want [d], 3", child.Faders(), g.Len())
+	}
+	if alphaA, alphaB := alphaOf(a), alphaOf(b); alphaA != 1 || alphaB != 1 {
+		t.Errorf("at double speed: alphas %v, %v; want 1, 1", alphaA, alphaB)
+	}
+	g.Update()
+	if len(child.Faders()) != 0 || g.Len() != 2 {
+		t.Errorf("after d finished: child faders %v, Len %v; want none, 2", child.Faders(), g.Len())
+	}
+	if g.AllFinished() {
+		t.Error("AllFinished while a and b are still playing = true")
+	}
+
+	// Only b can fade out on demand; a finishes on its own.
+	g.FadeOutAll(true)
+	if !b.IsFadeOutStarted() {
+		t.Error("FadeOutAll did not fade out b")
+	}
+	clock.AdvanceSeconds(1)
+	if !g.AllFinished() || g.AnyActive() {
+		t.Errorf("after the fade-outs: AllFinished, AnyActive = %v, %v; want true, false", g.AllFinished(), g.AnyActive())
+	}
+	g.FadeInAll()
+	if b.Phase() != FadeIn || !g.AnyActive() || g.AllFinished() {
+		t.Errorf("after FadeInAll: b Phase, AnyActive, AllFinished = %v, %v, %v; want FadeIn, true, false", b.Phase(), g.AnyActive(), g.AllFinished())
+	}
+
+	if !g.RemoveGroup(child) || g.RemoveGroup(child) || len(g.Groups()) != 0 {
+		t.Error("RemoveGroup should remove the child group exactly once")
+	}
+	if !g.Remove(a) || g.Remove(a) || g.Remove(c) || g.Len() != 1 {
+		t.Errorf("Remove: Len = %v, want 1", g.Len())
+	}
+}
+
+func TestEmptyFaderGroup(t *testing.T) {
+	g := NewFaderGroup()
+	g.AddGroup(NewFaderGroup())
+	if g.Len() != 0 || !g.AllFinished() || g.AnyActive() {
+		t.Errorf("empty group: Len, AllFinished, AnyActive = %v, %v, %v; want 0, true, false", g.Len(), g.AllFinished(), g.AnyActive())
+	}
+}
@@ -0,0 +1,96 @@
+package fade
+
+import (
+	math "github.com/chewxy/math32"
+)
+
+type loopKind int
+
+const (
+	loopOnce loopKind = iota
+	loopRepeat
+	loopForever
+	loopPingPong
+)
+
+// LoopMode controls what happens when a fade reaches its end.
+// The zero value is Once.
+type LoopMode struct {
+	kind  loopKind
+	count int
+}
+
+var (
+	// Once plays the fade a single time.
+	Once = LoopMode{kind: loopOnce}
+	// Loop restarts the fade from the beginning forever.
+	Loop = LoopMode{kind: loopForever}
+	// PingPong plays the fade forward, then backward, forever.
+	PingPong = LoopMode{kind: loopPingPong}
+)
+
+// Repeat plays the fade n times in a row. n is raised to 1 if smaller.
+func Repeat(n int) LoopMode {
+	if n < 1 {
+		n = 1
+	}
+	return LoopMode{kind: loopRepeat, count: n}
+}
+
+// Iterations returns how many times the fade plays, or 0 if it never ends.
+func (m LoopMode) Iterations() int {
+	switch m.kind {
+	case loopRepeat:
+		return m.count
+	case loopForever, loopPingPong:
+		return 0
+	}
+	return 1
+}
+
+// Apply maps time t since start to the time within the current iteration of
+// a fade lasting period seconds, and returns the zero-based iteration number.
+// Once a finite mode is over, the time keeps growing past period so the fade
+// stays in its final state.
+func (m LoopMode) Apply(t, period float32) (local float32, iteration int) {
+	if t < 0 || period <= 0 {
+		return t, 0
+	}
+	iteration = int(math.Floor(t / period))
+	if n := m.Iterations(); n > 0 && iteration >= n {
+		return t - float32(n-1)*period, n - 1
+	}
+	local = t - float32(iteration)*period
+	if m.kind == loopPingPong && iteration%2 == 1 {
+		local = period - local
+	}
+	return local, iteration
+}
+
+// Finished reports whether a fade lasting period seconds is over at time t.
+// Loop and PingPong never finish.
+func (m LoopMode) Finished(t, period float32) bool {
+	n := m.Iterations()
+	if n == 0 {
+		return false
+	}
+	return t > period*float32(n)
+}
+
+// AlphaLoop is Alpha repeated according to mode. fn also receives the
+// zero-based iteration number.
+func AlphaLoop(t, fadeIn, static, fadeOut float32, mode LoopMode, fn func(alpha float32, iteration int)) {
+	local, iteration := mode.Apply(t, fadeIn+static+fadeOut)
+	Alpha(local, fadeIn, static, fadeOut, func(alpha float32) {
+		fn(alpha, iteration)
+	})
+}
+
+// DeltaLoop is Delta repeated according to mode. fn also receives the
+// zero-based iteration number.
+func DeltaLoop(t, fadeIn, static, fadeOut, delta float32, mode LoopMode, fn func(delta float32, iteration int)) {
+	local, iteration := mode.Apply(t, fadeIn+static+fadeOut)
+	Delta(local, fadeIn, static, fadeOut, delta, func(d float32) {
+		fn(d, iteration)
+	})
+}
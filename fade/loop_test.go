@@ -0,0 +1,157 @@
+package fade
+
+import (
+	"testing"
+
+	optional "github.com/moznion/go-optional"
+)
+
+var loopModes = map[string]LoopMode{"Once": Once, "Repeat(2)": Repeat(2), "Loop": Loop, "PingPong": PingPong}
+
+func TestLoopModeApply(t *testing.T) {
+	for _, tc := range []struct {
+		mode      string
+		t         float32
+		local     float32
+		iteration int
+	}{
+		{"Once", -1, -1, 0},
+		{"Once", 2, 2, 0},
+		{"Once", 5, 5, 0},
+		{"Repeat(2)", 2, 2, 0},
+		{"Repeat(2)", 4, 1, 1},
+		{"Repeat(2)", 7, 4, 1},
+		{"Loop", 3, 0, 1},
+		{"Loop", 7, 1, 2},
+		{"PingPong", 1, 1, 0},
+		{"PingPong", 4, 2, 1},
+		{"PingPong", 7, 1, 2},
+	} {
+		local, iteration := loopModes[tc.mode].Apply(tc.t, 3)
+		if !approx(local, tc.local) || iteration != tc.iteration {
+			t.Errorf("%s Apply(%v, 3) = %v, %d; want %v, %d", tc.mode, tc.t, local, iteration, tc.local, tc.iteration)
+		}
+	}
+	if local, iteration := Loop.Apply(5, 0); local != 5 || iteration != 0 {
+		t.Errorf("Loop Apply(5, 0) = %v, %d; want 5, 0", local, iteration)
+	}
+}
+
+func TestLoopModeFinished(t *testing.T) {
+	for _, tc := range []struct {
+		mode       string
+		iterations int
+		end        float32
+	}{
+		{"Once", 1, 3},
+		{"Repeat(2)", 2, 6},
+		{"Loop", 0, 0},
+		{"PingPong", 0, 0},
+	} {
+		m := loopModes[tc.mode]
+		if got := m.Iterations(); got != tc.iterations {
+			t.Errorf("%s Iterations() = %d, want %d", tc.mode, got, tc.iterations)
+		}
+		if tc.iterations == 0 {
+			if m.Finished(1000, 3) {
+				t.Errorf("%s Finished(1000, 3) = true", tc.mode)
+			}
+			continue
+		}
+		if m.Finished(tc.end, 3) || !m.Finished(tc.end+0.01, 3) {
+			t.Errorf("%s should finish just after %v", tc.mode, tc.end)
+		}
+	}
+	if got := Repeat(0).Iterations(); got != 1 {
+		t.Errorf("Repeat(0).Iterations() = %d, want 1", got)
+	}
+}
+
+func TestNonInteractiveFaderRepeat(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock), WithLoopMode(Repeat(2)))
+	f.Start()
+	for _, tc := range []struct {
+		sec       float64
+		alpha     float32
+		iteration int
+		finished  bool
+	}{
+		{0.5, 0.5, 0, false},
+		{2.5, 0.5, 0, false},
+		{3.5, 0.5, 1, false},
+		{4.5, 1, 1, false},
+		{6, 0, 1, false},
+		{6.5, 0, 1, true},
+		{100, 0, 1, true},
+	} {
+		clock.Set(0)
+		clock.AdvanceSeconds(tc.sec)
+		if a, i, fin := alphaOf(f), f.Iteration(), f.IsFinished(); !approx(a, tc.alpha) || i != tc.iteration || fin != tc.finished {
+			t.Errorf("at %vs: alpha, Iteration, IsFinished = %v, %v, %v; want %v, %v, %v",
+				tc.sec, a, i, fin, tc.alpha, tc.iteration, tc.finished)
+		}
+	}
+}
+
+func TestNonInteractiveFaderPingPong(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 1, optional.Some[float32](2), WithClock(clock), WithLoopMode(PingPong))
+	f.Start()
+	// The second iteration plays backward: fade-out reversed, static, then
+	// fade-in reversed.
+	for _, tc := range []struct {
+		sec       float64
+		alpha     float32
+		phase     Phase
+		iteration int
+	}{
+		{0.5, 0.5, FadeIn, 0},
+		{3, 0.5, FadeOut, 0},
+		{4.5, 0.25, FadeOut, 1},
+		{6.5, 1, Static, 1},
+		{7.75, 0.25, FadeIn, 1},
+		{8.5, 0.5, FadeIn, 2},
+		{1000.5, 0.5, FadeIn, 250},
+	} {
+		clock.Set(0)
+		clock.AdvanceSeconds(tc.sec)
+		if a, p, i := alphaOf(f), f.Phase(), f.Iteration(); !approx(a, tc.alpha) || p != tc.phase || i != tc.iteration {
+			t.Errorf("at %vs: alpha, Phase, Iteration = %v, %v, %v; want %v, %v, %v",
+				tc.sec, a, p, i, tc.alpha, tc.phase, tc.iteration)
+		}
+		if f.IsFinished() {
+			t.Errorf("at %vs: PingPong IsFinished = true", tc.sec)
+		}
+	}
+}
+
+func TestAlphaDeltaLoop(t *testing.T) {
+	for _, tc := range []struct {
+		mode      string
+		t         float32
+		alpha     float32
+		iteration int
+	}{
+		{"Loop", 3.5, 0.5, 1},
+		{"PingPong", 5.5, 0.5, 1},
+		{"Repeat(2)", 4.5, 1, 1},
+		{"Repeat(2)", 10, 0, 1},
+		{"Once", 10, 0, 0},
+	} {
+		var gotAlpha, gotDelta float32
+		var gotIteration, deltaIteration int
+		AlphaLoop(tc.t, 1, 1, 1, loopModes[tc.mode], func(alpha float32, iteration int) {
+			gotAlpha, gotIteration = alpha, iteration
+		})
+		DeltaLoop(tc.t, 1, 1, 1, 10, loopModes[tc.mode], func(delta float32, iteration int) {
+			gotDelta, deltaIteration = delta, iteration
+		})
+		if !approx(gotAlpha, tc.alpha) || gotIteration != tc.iteration {
+			t.Errorf("%s AlphaLoop(%v) = %v, %d; want %v, %d", tc.mode, tc.t, gotAlpha, gotIteration, tc.alpha, tc.iteration)
+		}
+		if !approx(gotDelta, tc.alpha*10) || deltaIteration != tc.iteration {
+			t.Errorf("%s DeltaLoop(%v) = %v, %d; want %v, %d", tc.mode, tc.t, gotDelta, deltaIteration, tc.alpha*10, tc.iteration)
+		}
+	}
+}
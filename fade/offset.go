@@ -0,0 +1,52 @@
+package fade
+
+import (
+	optional "github.com/moznion/go-optional"
+)
+
+// Offset shifts a stateless fade in time. The fade waits Delay seconds in the
+// Delay phase before fading in, and PreRoll starts it as if it had already
+// been running for that many seconds (consuming the delay first).
+type Offset struct {
+	Delay   float32
+	PreRoll float32
+}
+
+// Apply maps t to the fade's own time. delayed is true while in the delay.
+func (o Offset) Apply(t float32) (local float32, delayed bool) {
+	local = t + o.PreRoll - o.Delay
+	return local, local < 0
+}
+
+// AdvancedOffset is AdvancedEase shifted by offset. While delayed, fn
+// receives zero rates and the Delay phase.
+func AdvancedOffset(
+	t float32,
+	offset Offset,
+	fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	fn func(rateEasing, rateTime float32, phase Phase),
+	easeIn, easeOut Easer,
+) {
+	local, delayed := offset.Apply(t)
+	if delayed {
+		fn(0, 0, Delay)
+		return
+	}
+	AdvancedEase(local, fadeIn, static, fadeOut, fn, easeIn, easeOut)
+}
+
+// SampleOffset is SampleMoreEase shifted by offset.
+func SampleOffset(
+	t float32,
+	offset Offset,
+	fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	easeIn, easeOut Easer,
+) Sample {
+	local, delayed := offset.Apply(t)
+	if delayed {
+		return Sample{Phase: Delay}
+	}
+	return SampleMoreEase(local, fadeIn, static, fadeOut, easeIn, easeOut)
+}
@@ -0,0 +1,137 @@
+package fade
+
+import (
+	"testing"
+
+	optional "github.com/moznion/go-optional"
+)
+
+func TestOffsetApply(t *testing.T) {
+	for _, tc := range []struct {
+		offset  Offset
+		t       float32
+		local   float32
+		delayed bool
+	}{
+		{Offset{}, 0, 0, false},
+		{Offset{Delay: 1}, 0.5, -0.5, true},
+		{Offset{Delay: 1}, 1, 0, false},
+		{Offset{PreRoll: 0.5}, 0, 0.5, false},
+		// Pre-roll consumes the delay first.
+		{Offset{Delay: 1, PreRoll: 0.25}, 0, -0.75, true},
+		{Offset{Delay: 1, PreRoll: 0.25}, 1, 0.25, false},
+		{Offset{Delay: 0.5, PreRoll: 1.5}, 0, 1, false},
+	} {
+		local, delayed := tc.offset.Apply(tc.t)
+		if !approx(local, tc.local) || delayed != tc.delayed {
+			t.Errorf("%+v Apply(%v) = %v, %v; want %v, %v", tc.offset, tc.t, local, delayed, tc.local, tc.delayed)
+		}
+	}
+}
+
+func TestSampleOffset(t *testing.T) {
+	offset := Offset{Delay: 1, PreRoll: 0.25}
+	for _, tc := range []struct {
+		t     float32
+		alpha float32
+		phase Phase
+	}{
+		{0, 0, Delay},
+		{0.74, 0, Delay},
+		{0.75, 0, FadeIn},
+		{1.25, 0.5, FadeIn},
+		{2.25, 1, Static},
+		{3.25, 0.5, FadeOut},
+	} {
+		s := SampleOffset(tc.t, offset, 1, 1, optional.Some[float32](1), nil, nil)
+		if !approx(s.Alpha, tc.alpha) || s.Phase != tc.phase {
+			t.Errorf("SampleOffset(%v) = %v, %v; want %v, %v", tc.t, s.Alpha, s.Phase, tc.alpha, tc.phase)
+		}
+		var rateTime float32
+		var phase Phase
+		AdvancedOffset(tc.t, offset, 1, 1, optional.Some[float32](1), func(rateEasing, rt float32, p Phase) {
+			rateTime, phase = rt, p
+		}, nil, nil)
+		if rateTime != s.RateTime || phase != s.Phase {
+			t.Errorf("AdvancedOffset(%v) = %v, %v; want %v, %v", tc.t, rateTime, phase, s.RateTime, s.Phase)
+		}
+	}
+}
+
+func TestNonInteractiveFaderDelay(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock), WithDelay(1), WithPreRoll(0.25))
+	if f.DelaySec != 1 || f.PreRollSec != 0.25 {
+		t.Fatalf("DelaySec, PreRollSec = %v, %v; want 1, 0.25", f.DelaySec, f.PreRollSec)
+	}
+	if d := f.Duration(); !approx(d, 3.75) {
+		t.Errorf("Duration = %v, want 3.75", d)
+	}
+	f.Start()
+	for _, tc := range []struct {
+		sec      float64
+		alpha    float32
+		phase    Phase
+		finished bool
+	}{
+		{0, 0, Delay, false},
+		{0.5, 0, Delay, false},
+		{0.75, 0, FadeIn, false},
+		{1.25, 0.5, FadeIn, false},
+		{2, 1, Static, false},
+		{3.25, 0.5, FadeOut, false},
+		{3.8, 0, FadeOut, true},
+	} {
+		clock.Set(0)
+		clock.AdvanceSeconds(tc.sec)
+		if a, p, fin := alphaOf(f), f.Phase(), f.IsFinished(); !approx(a, tc.alpha) || p != tc.phase || fin != tc.finished {
+			t.Errorf("at %vs: alpha, Phase, IsFinished = %v, %v, %v; want %v, %v, %v",
+				tc.sec, a, p, fin, tc.alpha, tc.phase, tc.finished)
+		}
+	}
+
+	// A pre-roll longer than the delay skips into the fade itself.
+	clock.Set(0)
+	f = NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock), WithDelay(0.5), WithPreRoll(1.5))
+	f.Start()
+	if a, p, d := alphaOf(f), f.Phase(), f.Duration(); a != 1 || p != Static || !approx(d, 2) {
+		t.Errorf("pre-roll past the delay: alpha, Phase, Duration = %v, %v, %v; want 1, Static, 2", a, p, d)
+	}
+}
+
+func TestInteractiveFaderDelay(t *testing.T) {
+	clock := NewManualClock()
+	f := NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock), WithDelay(1), WithPreRoll(0.5))
+	f.Start()
+	for _, tc := range []struct {
+		sec   float64
+		alpha float32
+		phase Phase
+	}{
+		{0.25, 0, Delay},
+		{0.5, 0, FadeIn},
+		{0.75, 0.25, FadeIn},
+		{2, 1, Static},
+	} {
+		clock.Set(0)
+		clock.AdvanceSeconds(tc.sec)
+		if a, p := alphaOf(f), f.Phase(); !approx(a, tc.alpha) || p != tc.phase {
+			t.Errorf("at %vs: alpha, Phase = %v, %v; want %v, %v", tc.sec, a, p, tc.alpha, tc.phase)
+		}
+	}
+
+	// Fading out during the delay fades out from a fade-in that just began,
+	// so the fader stays invisible and finishes.
+	clock.Set(0)
+	f = NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock), WithDelay(1))
+	f.Start()
+	clock.AdvanceSeconds(0.5)
+	f.FadeOut(true)
+	if a, p := alphaOf(f), f.Phase(); a != 0 || p != FadeOut {
+		t.Errorf("FadeOut during the delay: alpha, Phase = %v, %v; want 0, FadeOut", a, p)
+	}
+	clock.AdvanceSeconds(0.1)
+	if a := alphaOf(f); a != 0 || !f.IsFinished() {
+		t.Errorf("after FadeOut during the delay: alpha, IsFinished = %v, %v; want 0, true", a, f.IsFinished())
+	}
+}
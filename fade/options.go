@@ -0,0 +1,69 @@
+package fade
+
+// FaderOption configures a fader at construction time.
+type FaderOption func(*faderOptions)
+
+type faderOptions struct {
+	clock      Clock
+	loopMode   LoopMode
+	delay      float32
+	preRoll    float32
+	alphaCurve AlphaCurve
+	tps        func() float64
+}
+
+func newFaderOptions(opts []FaderOption) faderOptions {
+	o := faderOptions{clock: defaultClock}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithClock makes the fader read time from c instead of the system clock.
+func WithClock(c Clock) FaderOption {
+	return func(o *faderOptions) {
+		if c != nil {
+			o.clock = c
+		}
+	}
+}
+
+// WithLoopMode sets how a NonInteractiveFader repeats. See LoopMode.
+func WithLoopMode(m LoopMode) FaderOption {
+	return func(o *faderOptions) {
+		o.loopMode = m
+	}
+}
+
+// WithDelay makes the fader wait sec seconds in the Delay phase after Start.
+func WithDelay(sec float32) FaderOption {
+	return func(o *faderOptions) {
+		o.delay = sec
+	}
+}
+
+// WithPreRoll makes the fader start as if it had already been running for
+// sec seconds. Pre-roll consumes the delay first.
+func WithPreRoll(sec float32) FaderOption {
+	return func(o *faderOptions) {
+		o.preRoll = sec
+	}
+}
+
+// WithAlphaCurve sets the response curve applied to the fader's alpha after
+// easing. See AlphaCurve.
+func WithAlphaCurve(c AlphaCurve) FaderOption {
+	return func(o *faderOptions) {
+		o.alphaCurve = c
+	}
+}
+
+// WithTPS makes Update on tick-driven faders advance by 1/tps() seconds. tps
+// is called on every Update, so changes to the tick rate apply immediately;
+// a non-positive rate falls back to DefaultTPS. Other faders ignore it.
+func WithTPS(tps func() float64) FaderOption {
+	return func(o *faderOptions) {
+		o.tps = tps
+	}
+}
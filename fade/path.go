@@ -0,0 +1,96 @@
+package fade
+
+import optional "github.com/moznion/go-optional"
+
+// Path drives a value through a fade with separate enter and exit targets:
+// it goes from From to Rest during the fade-in, stays at Rest while static,
+// and goes on from Rest to To during the fade-out. An element can slide in
+// from the left and leave to the right, where a Tween would return the way
+// it came.
+type Path[T any] struct {
+	From, Rest, To T
+	Lerp           Interpolator[T]
+}
+
+func NewPath[T any](from, rest, to T, lerp Interpolator[T]) Path[T] {
+	return Path[T]{From: from, Rest: rest, To: to, Lerp: lerp}
+}
+
+// NewLerperPath returns a Path of a type that implements Lerper, such as
+// Vec2 and Vec3.
+func NewLerperPath[T Lerper[T]](from, rest, to T) Path[T] {
+	return NewPath(from, rest, to, LerpMethod[T])
+}
+
+// Sample returns the value for the state of a fade. The fade-in easing moves
+// the value towards Rest and the fade-out easing moves it towards To.
+func (p Path[T]) Sample(s Sample) T {
+	switch s.Phase {
+	case FadeIn:
+		return p.Lerp(p.From, p.Rest, s.RateEasing)
+	case FadeOut:
+		return p.Lerp(p.Rest, p.To, s.RateEasing)
+	case Static:
+		return p.Rest
+	}
+	return p.From
+}
+
+// More returns the value at time t along with the state of the fade.
+func (p Path[T]) More(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType,
+) (T, Sample) {
+	return p.MoreEase(t, fadeIn, static, fadeOut, NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+// MoreEase is More with arbitrary easing functions.
+func (p Path[T]) MoreEase(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	easeIn, easeOut Easer,
+) (T, Sample) {
+	s := SampleMoreEase(t, fadeIn, static, fadeOut, easeIn, easeOut)
+	return p.Sample(s), s
+}
+
+// Fader returns the current value of f.
+func (p Path[T]) Fader(f Fader, easeIn, easeOut Easer) T {
+	return p.Sample(f.SampleMoreEase(easeIn, easeOut))
+}
+
+// DeltaPath returns the position moving from→rest during the fade-in and
+// rest→to during the fade-out, along with the alpha of the fade.
+func DeltaPath(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	from, rest, to float32,
+	easeIn, easeOut Easer,
+) (value, alpha float32) {
+	v, s := NewPath(from, rest, to, LerpFloat[float32]).MoreEase(t, fadeIn, static, fadeOut, easeIn, easeOut)
+	return v, s.Alpha
+}
+
+// DeltaPathMore is DeltaMore with separate enter and exit targets.
+func DeltaPathMore(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	from, rest, to float32,
+	fn func(value, alpha, rateEasing, rateTime float32, phase Phase),
+	easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType,
+) {
+	DeltaPathMoreEase(t, fadeIn, static, fadeOut, from, rest, to, fn, NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+// DeltaPathMoreEase is DeltaPathMore with arbitrary easing functions.
+func DeltaPathMoreEase(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	from, rest, to float32,
+	fn func(value, alpha, rateEasing, rateTime float32, phase Phase),
+	easeIn, easeOut Easer,
+) {
+	v, s := NewPath(from, rest, to, LerpFloat[float32]).MoreEase(t, fadeIn, static, fadeOut, easeIn, easeOut)
+	fn(v, s.Alpha, s.RateEasing, s.RateTime, s.Phase)
+}
@@ -0,0 +1,132 @@
+package fade
+
+import (
+	optional "github.com/moznion/go-optional"
+)
+
+// Sample is the state of a fade at one point in time. Delta is the alpha
+// scaled by the delta the sample was taken with (1 unless WithDelta is used).
+// Linear is the eased alpha before any response curve. Neither is affected by
+// WithCurve.
+type Sample struct {
+	Alpha      float32
+	Delta      float32
+	Linear     float32
+	RateEasing float32
+	RateTime   float32
+	Phase      Phase
+}
+
+// WithDelta returns a copy of s whose Delta is scaled by delta, as DeltaMore does.
+func (s Sample) WithDelta(delta float32) Sample {
+	s.Delta *= delta
+	return s
+}
+
+// SampleMore returns the state AlphaMore and DeltaMore pass to their callbacks.
+// The returned Delta is for a delta of 1; use WithDelta to scale it.
+func SampleMore(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType,
+) Sample {
+	return SampleMoreEase(t, fadeIn, static, fadeOut, NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+// SampleMoreEase is SampleMore with arbitrary Easers for fade-in and fade-out.
+func SampleMoreEase(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	easeIn, easeOut Easer,
+) Sample {
+	rateEasing, rateTime, phase := advanced(t, fadeIn, static, fadeOut, easeIn, easeOut)
+	var alpha float32
+	switch phase {
+	case FadeIn:
+		alpha = rateEasing
+	case Static:
+		alpha = 1
+	case FadeOut:
+		alpha = 1 - rateEasing
+	}
+	return Sample{
+		Alpha:      alpha,
+		Delta:      alpha,
+		Linear:     alpha,
+		RateEasing: rateEasing,
+		RateTime:   rateTime,
+		Phase:      phase,
+	}
+}
+
+// AlphaValue returns the alpha Alpha passes to its callback.
+func AlphaValue(t, fadeIn, static, fadeOut float32) float32 {
+	return SampleMoreEase(t, fadeIn, static, optional.Some[float32](fadeOut), nil, nil).Alpha
+}
+
+// DeltaValue returns the delta Delta passes to its callback.
+func DeltaValue(t, fadeIn, static, fadeOut, delta float32) float32 {
+	return AlphaValue(t, fadeIn, static, fadeOut) * delta
+}
+
+// --- InteractiveFader ---
+
+// SampleMore returns the current state with the given easings.
+func (f *InteractiveFader) SampleMore(easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType) Sample {
+	return f.SampleMoreEase(NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+// SampleMoreEase returns the current state with arbitrary Easers.
+func (f *InteractiveFader) SampleMoreEase(easeIn, easeOut Easer) Sample {
+	f.dispatch()
+	t, staticSec, fadeOut, ok := f.timing()
+	if !ok {
+		return Sample{Phase: FadeIn}
+	}
+	if t < 0 {
+		return Sample{Phase: Delay}
+	}
+	return SampleMoreEase(t, f.FadeInSec, staticSec, optional.Some[float32](fadeOut), easeIn, easeOut).WithCurve(f.AlphaCurve)
+}
+
+// AlphaValue returns the current alpha with linear easing, after the alpha
+// curve.
+func (f *InteractiveFader) AlphaValue() float32 {
+	return f.SampleMoreEase(nil, nil).Alpha
+}
+
+// DeltaValue returns the current delta with linear easing.
+func (f *InteractiveFader) DeltaValue(delta float32) float32 {
+	return f.SampleMoreEase(nil, nil).WithDelta(delta).Delta
+}
+
+// --- NonInteractiveFader ---
+
+// SampleMore returns the current state with the given easings.
+func (f *NonInteractiveFader) SampleMore(easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType) Sample {
+	return f.SampleMoreEase(NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+// SampleMoreEase returns the current state with arbitrary Easers.
+func (f *NonInteractiveFader) SampleMoreEase(easeIn, easeOut Easer) Sample {
+	f.dispatch()
+	if !f.started {
+		return Sample{Phase: FadeIn}
+	}
+	t, _ := f.localTime()
+	if t < 0 {
+		return Sample{Phase: Delay}
+	}
+	return SampleMoreEase(t, f.FadeInSec, f.StaticSec, optional.Some[float32](f.fadeOutSec()), easeIn, easeOut).WithCurve(f.AlphaCurve)
+}
+
+// AlphaValue returns the current alpha with linear easing, after the alpha
+// curve.
+func (f *NonInteractiveFader) AlphaValue() float32 {
+	return f.SampleMoreEase(nil, nil).Alpha
+}
+
+// DeltaValue returns the current delta with linear easing.
+func (f *NonInteractiveFader) DeltaValue(delta float32) float32 {
+	return f.SampleMoreEase(nil, nil).WithDelta(delta).Delta
+}
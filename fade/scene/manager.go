@@ -0,0 +1,159 @@
+package scene
+
+import (
+	"image/color"
+
+	"github.com/funatsufumiya/ebiten_fade/fade"
+	"github.com/funatsufumiya/ebiten_fade/fade/ebitenfade"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	optional "github.com/moznion/go-optional"
+)
+
+var _ ebiten.Game = (*Manager)(nil)
+
+// Manager is an ebiten.Game that runs the current scene and fades between
+// scenes on Switch.
+type Manager struct {
+	current Scene
+	next    Scene
+	tr      Transition
+	fader   *fade.TickNonInteractiveFader
+}
+
+// NewManager returns a Manager showing first.
+func NewManager(first Scene) *Manager {
+	m := &Manager{current: first}
+	if e, ok := first.(Enterer); ok {
+		e.OnEnter()
+	}
+	return m
+}
+
+// Switch starts a transition to the scene to. Switching again before the old
+// scene has been covered only changes the target; switching while the new
+// scene is being uncovered starts covering again from the current cover
+// (exactly so for linear easing).
+func (m *Manager) Switch(to Scene, tr Transition) {
+	if m.InTransition() && !m.State().Swapped {
+		m.next = to
+		return
+	}
+	var cover float32
+	if m.InTransition() {
+		cover = m.State().Cover
+	}
+	m.next = to
+	m.tr = tr
+	m.fader = ebitenfade.NewTickNonInteractiveFader(tr.Out, tr.Hold, optional.Some[float32](tr.In))
+	m.fader.Seek(cover * tr.Out)
+	m.step()
+}
+
+// Current returns the scene that is, or will be after the swap, current.
+func (m *Manager) Current() Scene {
+	if m.next != nil {
+		return m.next
+	}
+	return m.current
+}
+
+// Visible returns the scene that is drawn right now.
+func (m *Manager) Visible() Scene {
+	return m.current
+}
+
+// InTransition reports whether a transition is in progress.
+func (m *Manager) InTransition() bool {
+	return m.fader != nil
+}
+
+// InputBlocked reports whether scenes should ignore input, which is the case
+// during transitions.
+func (m *Manager) InputBlocked() bool {
+	return m.InTransition()
+}
+
+// State returns the state of the transition in progress. It is tr.At of the
+// time played so far, so a transition ends exactly at its Duration and one
+// lasting no time swaps the scenes and ends within Switch.
+func (m *Manager) State() State {
+	if m.fader == nil {
+		return State{Stage: Idle, Swapped: true}
+	}
+	return m.tr.At(m.fader.Elapsed())
+}
+
+// Update advances the transition by one tick and updates the visible scene.
+func (m *Manager) Update() error {
+	if m.fader != nil {
+		m.fader.Update()
+	}
+	return m.update()
+}
+
+// UpdateDelta is Update advancing the transition by dt seconds instead of a tick.
+func (m *Manager) UpdateDelta(dt float32) error {
+	if m.fader != nil {
+		m.fader.UpdateDelta(dt)
+	}
+	return m.update()
+}
+
+func (m *Manager) update() error {
+	m.step()
+	if m.current == nil || (m.InTransition() && !m.tr.UpdateDuring) {
+		return nil
+	}
+	return m.current.Update()
+}
+
+// step swaps scenes and ends the transition according to the fader.
+func (m *Manager) step() {
+	if m.fader == nil {
+		return
+	}
+	st := m.State()
+	if st.Swapped && m.next != nil {
+		if e, ok := m.current.(Exiter); ok {
+			e.OnExit()
+		}
+		m.current, m.next = m.next, nil
+		if e, ok := m.current.(Enterer); ok {
+			e.OnEnter()
+		}
+	}
+	if st.Stage == Idle {
+		m.fader = nil
+	}
+}
+
+// Draw draws the visible scene and the transition cover over it.
+func (m *Manager) Draw(screen *ebiten.Image) {
+	if m.current != nil {
+		m.current.Draw(screen)
+	}
+	fill(screen, m.tr.Color, m.State().Cover)
+}
+
+// fill covers screen with c (nil is black) scaled by alpha.
+func fill(screen *ebiten.Image, c color.Color, alpha float32) {
+	if alpha <= 0 {
+		return
+	}
+	nc := color.NRGBA{A: 255}
+	if c != nil {
+		nc = color.NRGBAModel.Convert(c).(color.NRGBA)
+	}
+	nc.A = uint8(float32(nc.A)*min(alpha, 1) + 0.5)
+	b := screen.Bounds()
+	vector.DrawFilledRect(screen, float32(b.Min.X), float32(b.Min.Y), float32(b.Dx()), float32(b.Dy()), nc, false)
+}
+
+// Layout delegates to the current scene if it is a Layouter.
+func (m *Manager) Layout(outsideWidth, outsideHeight int) (int, int) {
+	if l, ok := m.current.(Layouter); ok {
+		return l.Layout(outsideWidth, outsideHeight)
+	}
+	return outsideWidth, outsideHeight
+}
@@ -0,0 +1,155 @@
+package scene
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type testScene struct {
+	name                   string
+	updates, enters, exits int
+}
+
+func (s *testScene) Update() error             { s.updates++; return nil }
+func (s *testScene) Draw(screen *ebiten.Image) {}
+func (s *testScene) OnEnter()                  { s.enters++ }
+func (s *testScene) OnExit()                   { s.exits++ }
+
+func checkState(t *testing.T, m *Manager, stage Stage, cover float32, visible Scene) {
+	t.Helper()
+	st := m.State()
+	if st.Stage != stage || !approx(st.Cover, cover) {
+		t.Errorf("State = %+v, want stage %v with cover %v", st, stage, cover)
+	}
+	if m.Visible() != visible {
+		t.Errorf("Visible = %v, want %v", m.Visible().(*testScene).name, visible.(*testScene).name)
+	}
+}
+
+func TestManagerSwitch(t *testing.T) {
+	a, b := &testScene{name: "a"}, &testScene{name: "b"}
+	m := NewManager(a)
+	if a.enters != 1 {
+		t.Fatalf("first scene entered %d times, want 1", a.enters)
+	}
+	m.UpdateDelta(0.1)
+	if a.updates != 1 {
+		t.Fatalf("idle scene updated %d times, want 1", a.updates)
+	}
+
+	m.Switch(b, Fade(1, 0.5, 1))
+	if !m.InTransition() || !m.InputBlocked() {
+		t.Fatal("Switch did not start a transition")
+	}
+	m.UpdateDelta(0.5)
+	checkState(t, m, Covering, 0.5, a)
+	m.UpdateDelta(0.75)
+	checkState(t, m, Covered, 1, b)
+	if a.exits != 1 || b.enters != 1 {
+		t.Errorf("after the swap a.exits = %d, b.enters = %d, want 1 and 1", a.exits, b.enters)
+	}
+	m.UpdateDelta(0.75)
+	checkState(t, m, Uncovering, 0.5, b)
+	m.UpdateDelta(1)
+	checkState(t, m, Idle, 0, b)
+	if m.InTransition() {
+		t.Error("InTransition after the transition = true")
+	}
+	if a.updates != 1 || b.updates != 1 {
+		t.Errorf("updates a = %d, b = %d, want 1 and 1: scenes must not update during a transition", a.updates, b.updates)
+	}
+}
+
+func TestManagerSwitchRetargetsWhileCovering(t *testing.T) {
+	a, b, c := &testScene{name: "a"}, &testScene{name: "b"}, &testScene{name: "c"}
+	m := NewManager(a)
+	m.Switch(b, Fade(1, 0, 1))
+	m.UpdateDelta(0.5)
+
+	m.Switch(c, Fade(1, 0, 1))
+	checkState(t, m, Covering, 0.5, a)
+	if m.Current() != c {
+		t.Error("Current after retargeting is not the new target")
+	}
+
+	m.UpdateDelta(0.75)
+	checkState(t, m, Uncovering, 0.75, c)
+	if b.enters != 0 || c.enters != 1 || a.exits != 1 {
+		t.Errorf("enters b = %d, c = %d, a.exits = %d; want 0, 1, 1", b.enters, c.enters, a.exits)
+	}
+}
+
+func TestManagerSwitchRecoversWhileUncovering(t *testing.T) {
+	a, b, c := &testScene{name: "a"}, &testScene{name: "b"}, &testScene{name: "c"}
+	m := NewManager(a)
+	m.Switch(b, Fade(1, 0, 1))
+	m.UpdateDelta(1.75)
+	checkState(t, m, Uncovering, 0.25, b)
+
+	// Covering starts again from the current cover instead of jumping.
+	m.Switch(c, Fade(1, 0, 1))
+	checkState(t, m, Covering, 0.25, b)
+	m.UpdateDelta(0.25)
+	checkState(t, m, Covering, 0.5, b)
+	m.UpdateDelta(0.6)
+	checkState(t, m, Uncovering, 0.9, c)
+	if b.exits != 1 || c.enters != 1 {
+		t.Errorf("b.exits = %d, c.enters = %d, want 1 and 1", b.exits, c.enters)
+	}
+}
+
+func TestManagerUpdateDuring(t *testing.T) {
+	a, b := &testScene{name: "a"}, &testScene{name: "b"}
+	m := NewManager(a)
+	tr := Fade(1, 0, 1)
+	tr.UpdateDuring = true
+	m.Switch(b, tr)
+	m.UpdateDelta(0.5)
+	m.UpdateDelta(1)
+	if a.updates != 1 || b.updates != 1 {
+		t.Errorf("updates a = %d, b = %d, want 1 and 1 with UpdateDuring", a.updates, b.updates)
+	}
+	if !m.InputBlocked() {
+		t.Error("InputBlocked during the transition = false")
+	}
+}
+
+func TestManagerEndsWithTransitionAt(t *testing.T) {
+	a, b := &testScene{name: "a"}, &testScene{name: "b"}
+	m := NewManager(a)
+	tr := Fade(1, 0.5, 1)
+	m.Switch(b, tr)
+	for elapsed := float32(0.25); elapsed <= 3; elapsed += 0.25 {
+		m.UpdateDelta(0.25)
+		want := tr.At(elapsed)
+		if got := m.State(); got != want {
+			t.Errorf("State at %v = %+v, want %+v", elapsed, got, want)
+		}
+		if got := m.InTransition(); got != (want.Stage != Idle) {
+			t.Errorf("InTransition at %v = %v, want %v", elapsed, got, want.Stage != Idle)
+		}
+	}
+	// The transition ends on the tick that reaches its Duration, and that
+	// tick already updates the new scene.
+	if b.updates != 3 {
+		t.Errorf("b updated %d times, want 3", b.updates)
+	}
+}
+
+func TestManagerInstantSwitch(t *testing.T) {
+	a, b := &testScene{name: "a"}, &testScene{name: "b"}
+	m := NewManager(a)
+	m.Switch(b, Fade(0, 0, 0))
+	if m.InTransition() || m.InputBlocked() {
+		t.Errorf("after an instant Switch: InTransition, InputBlocked = %v, %v; want false, false", m.InTransition(), m.InputBlocked())
+	}
+	checkState(t, m, Idle, 0, b)
+	if a.exits != 1 || b.enters != 1 {
+		t.Errorf("a.exits = %d, b.enters = %d, want 1 and 1", a.exits, b.enters)
+	}
+	m.UpdateDelta(0.1)
+	if a.updates != 0 || b.updates != 1 {
+		t.Errorf("updates a = %d, b = %d, want 0 and 1", a.updates, b.updates)
+	}
+}
@@ -0,0 +1,111 @@
+// Package scene switches between ebiten scenes with fade transitions.
+package scene
+
+import (
+	"image/color"
+
+	"github.com/funatsufumiya/ebiten_fade/fade"
+	"github.com/hajimehoshi/ebiten/v2"
+	optional "github.com/moznion/go-optional"
+)
+
+// Scene is one screen of a game.
+type Scene interface {
+	Update() error
+	Draw(screen *ebiten.Image)
+}
+
+// Enterer is implemented by scenes that want to know when they become current.
+type Enterer interface {
+	OnEnter()
+}
+
+// Exiter is implemented by scenes that want to know when they stop being current.
+type Exiter interface {
+	OnExit()
+}
+
+// Layouter is implemented by scenes that choose their own screen size.
+type Layouter interface {
+	Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int)
+}
+
+// Transition describes how one scene is replaced by another: the old scene
+// is covered over Out seconds, the screen stays covered for Hold seconds,
+// then the new scene is uncovered over In seconds.
+type Transition struct {
+	Out, Hold, In float32
+	// EaseOut and EaseIn shape the covering and uncovering. nil is linear.
+	EaseOut, EaseIn fade.Easer
+	// Color of the cover. nil is black.
+	Color color.Color
+	// UpdateDuring keeps calling Update on the visible scene during the
+	// transition. Scenes can check Manager.InputBlocked to ignore input.
+	UpdateDuring bool
+}
+
+// Fade returns a fade-through-black transition.
+func Fade(out, hold, in float32) Transition {
+	return Transition{Out: out, Hold: hold, In: in}
+}
+
+// Duration returns the total length of the transition.
+func (tr Transition) Duration() float32 {
+	return tr.Out + tr.Hold + tr.In
+}
+
+// Stage is the part of a transition in progress.
+type Stage int
+
+const (
+	Idle Stage = iota
+	Covering
+	Covered
+	Uncovering
+)
+
+func (s Stage) String() string {
+	switch s {
+	case Idle:
+		return "Idle"
+	case Covering:
+		return "Covering"
+	case Covered:
+		return "Covered"
+	case Uncovering:
+		return "Uncovering"
+	}
+	return "Unknown"
+}
+
+// State is the state of a transition at one point in time.
+type State struct {
+	Stage Stage
+	// Cover is the alpha of the cover, from 0 (invisible) to 1 (opaque).
+	Cover float32
+	// Swapped is true once the new scene has replaced the old one.
+	Swapped bool
+}
+
+// At returns the state of tr at t seconds after it started.
+func (tr Transition) At(t float32) State {
+	if t >= tr.Duration() {
+		return State{Stage: Idle, Swapped: true}
+	}
+	return stateOf(fade.SampleMoreEase(t, tr.Out, tr.Hold, optional.Some[float32](tr.In), tr.EaseOut, tr.EaseIn))
+}
+
+// stateOf maps a fade sample, whose fade-in covers and fade-out uncovers,
+// to a transition state.
+func stateOf(s fade.Sample) State {
+	st := State{Cover: min(max(s.Alpha, 0), 1)}
+	switch s.Phase {
+	case fade.FadeIn, fade.Delay:
+		st.Stage = Covering
+	case fade.Static:
+		st.Stage, st.Swapped = Covered, true
+	case fade.FadeOut:
+		st.Stage, st.Swapped = Uncovering, true
+	}
+	return st
+}
@@ -0,0 +1,44 @@
+package scene
+
+import (
+	"math"
+	"testing"
+)
+
+func approx(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 1e-4
+}
+
+func TestTransitionAt(t *testing.T) {
+	tr := Fade(1, 0.5, 1)
+	for _, tc := range []struct {
+		t    float32
+		want State
+	}{
+		{-1, State{Stage: Covering, Cover: 0}},
+		{0, State{Stage: Covering, Cover: 0}},
+		{0.5, State{Stage: Covering, Cover: 0.5}},
+		{1, State{Stage: Covered, Cover: 1, Swapped: true}},
+		{1.25, State{Stage: Covered, Cover: 1, Swapped: true}},
+		{1.5, State{Stage: Uncovering, Cover: 1, Swapped: true}},
+		{2, State{Stage: Uncovering, Cover: 0.5, Swapped: true}},
+		{2.5, State{Stage: Idle, Cover: 0, Swapped: true}},
+		{10, State{Stage: Idle, Cover: 0, Swapped: true}},
+	} {
+		got := tr.At(tc.t)
+		if got.Stage != tc.want.Stage || got.Swapped != tc.want.Swapped || !approx(got.Cover, tc.want.Cover) {
+			t.Errorf("At(%v) = %+v, want %+v", tc.t, got, tc.want)
+		}
+	}
+}
+
+func TestTransitionAtWithoutHoldOrCover(t *testing.T) {
+	// Without a fade-out the scenes swap immediately.
+	got := Fade(0, 0, 1).At(0)
+	if got.Stage != Uncovering || !got.Swapped || !approx(got.Cover, 1) {
+		t.Errorf("Fade(0, 0, 1).At(0) = %+v, want uncovering from a full cover", got)
+	}
+	if d := Fade(1, 0.5, 1).Duration(); d != 2.5 {
+		t.Errorf("Duration = %v, want 2.5", d)
+	}
+}
@@ -0,0 +1,275 @@
+package scene
+
+import (
+	"image/color"
+
+	"github.com/funatsufumiya/ebiten_fade/fade"
+	"github.com/funatsufumiya/ebiten_fade/fade/ebitenfade"
+	"github.com/hajimehoshi/ebiten/v2"
+	optional "github.com/moznion/go-optional"
+)
+
+var (
+	_ ebiten.Game = (*Stack)(nil)
+	_ Scene       = (*Stack)(nil)
+)
+
+// LayerOptions describes how a layer is pushed onto and popped off a Stack.
+type LayerOptions struct {
+	// FadeIn and FadeOut are the push and pop durations in seconds.
+	FadeIn, FadeOut float32
+	// EaseIn and EaseOut shape the push and pop fades. nil is linear.
+	EaseIn, EaseOut fade.Easer
+	// Dim is the alpha of the color drawn between this layer and the layers
+	// below it once fully faded in.
+	Dim float32
+	// DimColor is the color of the dim. nil is black.
+	DimColor color.Color
+	// Opaque layers hide everything below them once their fade-in has
+	// finished, so the layers below are no longer drawn.
+	Opaque bool
+	// UpdateBelow keeps calling Update on the layers below this one.
+	UpdateBelow bool
+}
+
+// LayerState is the state of one layer of a Stack.
+type LayerState struct {
+	Scene Scene
+	// Alpha is the fade of the layer itself, from 0 to 1.
+	Alpha float32
+	// Dim is the alpha of the dim drawn below the layer, already scaled by Alpha.
+	Dim float32
+	// Popping is true while the layer fades out before being removed.
+	Popping bool
+	// Updates and Draws report whether the layer receives Update and Draw.
+	Updates, Draws bool
+}
+
+type layer struct {
+	scene     Scene
+	opts      LayerOptions
+	fader     *fade.TickInteractiveFader
+	popping   bool
+	offscreen *ebiten.Image
+}
+
+// alpha returns the current fade of the layer, clamped since easings such as
+// Back overshoot. The root layer has no fader and is always fully visible.
+func (l *layer) alpha() float32 {
+	if l.fader == nil {
+		return 1
+	}
+	return fade.ClampAlpha(l.fader.SampleMoreEase(l.opts.EaseIn, l.opts.EaseOut).Alpha)
+}
+
+// settled reports whether the layer has finished fading in and is not
+// popping out. An overshooting fade-in reaches alpha 1 before it settles.
+func (l *layer) settled() bool {
+	return l.fader == nil || l.fader.Phase() == fade.Static
+}
+
+// Stack is a stack of scenes, such as a game with a pause menu and a dialog
+// on top of it. Pushed layers fade in over the layers below, popped layers
+// fade out before they are removed.
+//
+// A Stack is both an ebiten.Game and a Scene, so it can be run directly or
+// switched to by a Manager.
+type Stack struct {
+	layers []*layer
+}
+
+// NewStack returns a Stack with root at the bottom. The root layer cannot be
+// popped.
+func NewStack(root Scene) *Stack {
+	s := &Stack{layers: []*layer{{scene: root}}}
+	if e, ok := root.(Enterer); ok {
+		e.OnEnter()
+	}
+	return s
+}
+
+// Push fades sc in on top of the stack.
+func (s *Stack) Push(sc Scene, opts LayerOptions) {
+	l := &layer{
+		scene: sc,
+		opts:  opts,
+		fader: ebitenfade.NewTickInteractiveFader(opts.FadeIn, optional.Some[float32](opts.FadeOut)),
+	}
+	l.fader.Start()
+	s.layers = append(s.layers, l)
+	if e, ok := sc.(Enterer); ok {
+		e.OnEnter()
+	}
+}
+
+// Pop fades the topmost layer that is not already popping out and removes
+// it once the fade-out completes. It reports false if only the root is left.
+func (s *Stack) Pop() bool {
+	for i := len(s.layers) - 1; i > 0; i-- {
+		l := s.layers[i]
+		if l.popping {
+			continue
+		}
+		l.popping = true
+		if l.opts.FadeOut <= 0 {
+			s.remove(i)
+		} else {
+			l.fader.FadeOut(true)
+		}
+		return true
+	}
+	return false
+}
+
+func (s *Stack) remove(i int) {
+	l := s.layers[i]
+	s.layers = append(s.layers[:i], s.layers[i+1:]...)
+	if l.offscreen != nil {
+		l.offscreen.Deallocate()
+	}
+	if e, ok := l.scene.(Exiter); ok {
+		e.OnExit()
+	}
+}
+
+// Top returns the topmost scene that is not popping out.
+func (s *Stack) Top() Scene {
+	for i := len(s.layers) - 1; i >= 0; i-- {
+		if !s.layers[i].popping {
+			return s.layers[i].scene
+		}
+	}
+	return nil
+}
+
+// Len returns the number of layers, including the root and popping layers.
+func (s *Stack) Len() int {
+	return len(s.layers)
+}
+
+// InTransition reports whether any layer is fading in or out.
+func (s *Stack) InTransition() bool {
+	for _, l := range s.layers {
+		if l.fader != nil && (l.popping || l.fader.Phase() == fade.FadeIn) {
+			return true
+		}
+	}
+	return false
+}
+
+// Layers returns the state of every layer, bottom first.
+func (s *Stack) Layers() []LayerState {
+	states := make([]LayerState, len(s.layers))
+	for i, l := range s.layers {
+		a := l.alpha()
+		states[i] = LayerState{
+			Scene:   l.scene,
+			Alpha:   a,
+			Dim:     l.opts.Dim * a,
+			Popping: l.popping,
+		}
+	}
+
+	// Popping layers neither receive input nor hold it back from the layers
+	// below; the first layer that is not popping does, and passes it on only
+	// if it asks to.
+	for i := len(s.layers) - 1; i >= 0; i-- {
+		if s.layers[i].popping {
+			continue
+		}
+		states[i].Updates = true
+		if !s.layers[i].opts.UpdateBelow {
+			break
+		}
+	}
+
+	for i := len(s.layers) - 1; i >= 0; i-- {
+		states[i].Draws = true
+		if s.layers[i].opts.Opaque && s.layers[i].settled() {
+			break
+		}
+	}
+	return states
+}
+
+// Update advances the layer fades by one tick and updates the layers that
+// receive input.
+func (s *Stack) Update() error {
+	for _, l := range s.layers {
+		if l.fader != nil {
+			l.fader.Update()
+		}
+	}
+	return s.update()
+}
+
+// UpdateDelta is Update advancing the layer fades by dt seconds instead of a tick.
+func (s *Stack) UpdateDelta(dt float32) error {
+	for _, l := range s.layers {
+		if l.fader != nil {
+			l.fader.UpdateDelta(dt)
+		}
+	}
+	return s.update()
+}
+
+func (s *Stack) update() error {
+	for i := len(s.layers) - 1; i > 0; i-- {
+		if l := s.layers[i]; l.popping && l.fader.IsFinished() {
+			s.remove(i)
+		}
+	}
+	// Update bottom first; a scene may push or pop while updating, which
+	// takes effect on the next frame.
+	layers := append([]*layer(nil), s.layers...)
+	for i, st := range s.Layers() {
+		if !st.Updates {
+			continue
+		}
+		if err := layers[i].scene.Update(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Draw draws the visible layers bottom first, each over the dim of the
+// layers below it. Layers that are partially faded are drawn through an
+// offscreen image so their alpha applies to the scene as a whole.
+func (s *Stack) Draw(screen *ebiten.Image) {
+	for i, st := range s.Layers() {
+		if !st.Draws {
+			continue
+		}
+		l := s.layers[i]
+		fill(screen, l.opts.DimColor, st.Dim)
+		if st.Alpha >= 1 {
+			l.scene.Draw(screen)
+			continue
+		}
+		if st.Alpha <= 0 {
+			continue
+		}
+		b := screen.Bounds()
+		if l.offscreen == nil || l.offscreen.Bounds().Size() != b.Size() {
+			if l.offscreen != nil {
+				l.offscreen.Deallocate()
+			}
+			l.offscreen = ebiten.NewImage(b.Dx(), b.Dy())
+		}
+		l.offscreen.Clear()
+		l.scene.Draw(l.offscreen)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(float64(b.Min.X), float64(b.Min.Y))
+		op.ColorScale.ScaleAlpha(st.Alpha)
+		screen.DrawImage(l.offscreen, op)
+	}
+}
+
+// Layout delegates to the root scene if it is a Layouter.
+func (s *Stack) Layout(outsideWidth, outsideHeight int) (int, int) {
+	if l, ok := s.layers[0].scene.(Layouter); ok {
+		return l.Layout(outsideWidth, outsideHeight)
+	}
+	return outsideWidth, outsideHeight
+}
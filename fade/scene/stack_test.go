@@ -0,0 +1,101 @@
+package scene
+
+import (
+	"testing"
+
+	"github.com/funatsufumiya/ebiten_fade/fade"
+)
+
+type layerFlags struct {
+	updates, draws bool
+}
+
+func checkLayers(t *testing.T, s *Stack, want ...layerFlags) []LayerState {
+	t.Helper()
+	states := s.Layers()
+	if len(states) != len(want) {
+		t.Fatalf("Layers has %d layers, want %d", len(states), len(want))
+	}
+	for i, st := range states {
+		if st.Updates != want[i].updates || st.Draws != want[i].draws {
+			t.Errorf("layer %d Updates, Draws = %v, %v; want %v, %v", i, st.Updates, st.Draws, want[i].updates, want[i].draws)
+		}
+		if st.Alpha < 0 || st.Alpha > 1 {
+			t.Errorf("layer %d Alpha = %v, want within [0, 1]", i, st.Alpha)
+		}
+	}
+	return states
+}
+
+func TestStackPushPop(t *testing.T) {
+	root, menu, dialog := &testScene{name: "root"}, &testScene{name: "menu"}, &testScene{name: "dialog"}
+	s := NewStack(root)
+	checkLayers(t, s, layerFlags{true, true})
+
+	s.Push(menu, LayerOptions{FadeIn: 1, FadeOut: 1, Dim: 0.5, Opaque: true})
+	if menu.enters != 1 || s.Top() != menu || !s.InTransition() {
+		t.Fatalf("after Push: enters = %d, Top = %v, InTransition = %v", menu.enters, s.Top(), s.InTransition())
+	}
+	s.UpdateDelta(0.5)
+	states := checkLayers(t, s, layerFlags{false, true}, layerFlags{true, true})
+	if !approx(states[1].Alpha, 0.5) || !approx(states[1].Dim, 0.25) {
+		t.Errorf("menu halfway in: Alpha, Dim = %v, %v; want 0.5, 0.25", states[1].Alpha, states[1].Dim)
+	}
+	s.UpdateDelta(0.75)
+	states = checkLayers(t, s, layerFlags{false, false}, layerFlags{true, true})
+	if states[1].Alpha != 1 || !approx(states[1].Dim, 0.5) || s.InTransition() {
+		t.Errorf("menu faded in: Alpha, Dim, InTransition = %v, %v, %v; want 1, 0.5, false", states[1].Alpha, states[1].Dim, s.InTransition())
+	}
+
+	s.Push(dialog, LayerOptions{FadeIn: 1, FadeOut: 1, UpdateBelow: true})
+	s.UpdateDelta(1.5)
+	checkLayers(t, s, layerFlags{false, false}, layerFlags{true, true}, layerFlags{true, true})
+
+	if !s.Pop() {
+		t.Fatal("Pop with three layers = false")
+	}
+	if s.Top() != menu {
+		t.Errorf("Top while the dialog pops = %v, want the menu", s.Top())
+	}
+	s.UpdateDelta(0.5)
+	states = checkLayers(t, s, layerFlags{false, false}, layerFlags{true, true}, layerFlags{false, true})
+	if !states[2].Popping || !approx(states[2].Alpha, 0.5) {
+		t.Errorf("dialog halfway out: Popping, Alpha = %v, %v; want true, 0.5", states[2].Popping, states[2].Alpha)
+	}
+	s.UpdateDelta(0.75)
+	if s.Len() != 2 || dialog.exits != 1 {
+		t.Errorf("after the pop: Len = %d, dialog exits = %d; want 2, 1", s.Len(), dialog.exits)
+	}
+
+	// Popping the opaque menu shows the root again right away.
+	s.Pop()
+	checkLayers(t, s, layerFlags{true, true}, layerFlags{false, true})
+	s.UpdateDelta(1.25)
+	checkLayers(t, s, layerFlags{true, true})
+	if s.Pop() {
+		t.Error("Pop with only the root = true")
+	}
+
+	// The root only updates once the menu is gone, the menu on every frame
+	// until its pop, the dialog only while it is on top.
+	if root.updates != 1 || menu.updates != 5 || dialog.updates != 1 {
+		t.Errorf("updates root, menu, dialog = %d, %d, %d", root.updates, menu.updates, dialog.updates)
+	}
+}
+
+func TestStackOvershootKeepsLayersBelow(t *testing.T) {
+	back := fade.NewEaser(fade.Back, fade.Out)
+	if back(0.6) <= 1 {
+		t.Fatalf("BackEaseOut(0.6) = %v, want overshoot", back(0.6))
+	}
+	root, menu := &testScene{name: "root"}, &testScene{name: "menu"}
+	s := NewStack(root)
+	s.Push(menu, LayerOptions{FadeIn: 1, FadeOut: 1, EaseIn: back, Dim: 0.5, Opaque: true})
+	s.UpdateDelta(0.6)
+	states := checkLayers(t, s, layerFlags{false, true}, layerFlags{true, true})
+	if states[1].Alpha != 1 || states[1].Dim != 0.5 {
+		t.Errorf("overshooting menu: Alpha, Dim = %v, %v; want 1, 0.5", states[1].Alpha, states[1].Dim)
+	}
+	s.UpdateDelta(0.6)
+	checkLayers(t, s, layerFlags{false, false}, layerFlags{true, true})
+}
@@ -0,0 +1,138 @@
+package fade
+
+import (
+	math "github.com/chewxy/math32"
+	optional "github.com/moznion/go-optional"
+)
+
+// StaggerFrom selects where a stagger starts.
+type StaggerFrom int
+
+const (
+	StaggerFromStart StaggerFrom = iota
+	StaggerFromEnd
+	StaggerFromCenter
+	StaggerRandom
+)
+
+// Stagger spreads the start of a list (or grid) of items over time so they
+// fade in one after another.
+type Stagger struct {
+	// Each is the delay between two neighbouring items in seconds.
+	Each float32
+	// From selects the item(s) that start first.
+	From StaggerFrom
+	// Ease distributes the delays non-linearly over the whole span.
+	// nil is linear.
+	Ease Easer
+	// Seed makes StaggerRandom reproducible.
+	Seed uint64
+	// Cols, when positive, lays the items out row-major in rows of Cols
+	// items, with as many rows as the count needs, and orders them by
+	// distance from the origin chosen by From. StaggerFromEnd starts at the
+	// last item.
+	Cols int
+}
+
+// Offset returns the start delay of item index out of count items.
+func (s Stagger) Offset(index, count int) float32 {
+	rank, maxRank := s.rank(index, count)
+	if maxRank <= 0 {
+		return 0
+	}
+	return s.Ease.ease(rank/maxRank) * maxRank * s.Each
+}
+
+// Span returns the delay of the item that starts last.
+func (s Stagger) Span(count int) float32 {
+	_, maxRank := s.rank(0, count)
+	return maxRank * s.Each
+}
+
+// rank returns the distance of an item from the stagger origin, in items,
+// along with the largest possible distance.
+func (s Stagger) rank(index, count int) (rank, maxRank float32) {
+	if count <= 1 {
+		return 0, 0
+	}
+	last := float32(count - 1)
+	if s.From == StaggerRandom {
+		return s.random(index) * last, last
+	}
+	if s.Cols > 0 {
+		return s.gridRank(index, count)
+	}
+	i := float32(index)
+	switch s.From {
+	case StaggerFromEnd:
+		return last - i, last
+	case StaggerFromCenter:
+		return math.Abs(i - last/2), last / 2
+	}
+	return i, last
+}
+
+// gridRank lays count items out in rows of Cols, the last row possibly
+// partial. The farthest item from the origin is at a corner of that shape.
+func (s Stagger) gridRank(index, count int) (rank, maxRank float32) {
+	cols := min(s.Cols, count)
+	rows := (count + cols - 1) / cols
+	w, h := float32(cols-1), float32(rows-1)
+	lastX := float32((count - 1) % cols)
+	var ox, oy float32
+	switch s.From {
+	case StaggerFromEnd:
+		ox, oy = lastX, h
+	case StaggerFromCenter:
+		ox, oy = w/2, h/2
+	}
+	corners := [][2]float32{{0, 0}, {w, 0}, {0, h}, {lastX, h}, {w, max(h-1, 0)}}
+	for _, c := range corners {
+		maxRank = max(maxRank, math.Hypot(c[0]-ox, c[1]-oy))
+	}
+	x, y := float32(index%cols), float32(index/cols)
+	return math.Hypot(x-ox, y-oy), maxRank
+}
+
+// random returns a reproducible value in [0, 1) for index.
+func (s Stagger) random(index int) float32 {
+	// splitmix64
+	z := s.Seed + uint64(index+1)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z ^= z >> 31
+	return float32(z>>40) / (1 << 24)
+}
+
+// Sample returns the state of item index out of count at time t, where every
+// item plays the same fade delayed by its Offset.
+func (s Stagger) Sample(
+	t float32, index, count int,
+	fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	easeIn, easeOut Easer,
+) Sample {
+	return SampleOffset(t, Offset{Delay: s.Offset(index, count)}, fadeIn, static, fadeOut, easeIn, easeOut)
+}
+
+// AlphaMore calls fn with the state of item index out of count at time t.
+func (s Stagger) AlphaMore(
+	t float32, index, count int,
+	fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	fn func(alpha, rateEasing, rateTime float32, phase Phase),
+	easeIn, easeOut Easer,
+) {
+	v := s.Sample(t, index, count, fadeIn, static, fadeOut, easeIn, easeOut)
+	fn(v.Alpha, v.RateEasing, v.RateTime, v.Phase)
+}
+
+// AlphaValue returns the linear alpha of item index out of count at time t.
+func (s Stagger) AlphaValue(t float32, index, count int, fadeIn, static, fadeOut float32) float32 {
+	return s.Sample(t, index, count, fadeIn, static, optional.Some[float32](fadeOut), nil, nil).Alpha
+}
+
+// DeltaValue returns the linear delta of item index out of count at time t.
+func (s Stagger) DeltaValue(t float32, index, count int, fadeIn, static, fadeOut, delta float32) float32 {
+	return s.AlphaValue(t, index, count, fadeIn, static, fadeOut) * delta
+}
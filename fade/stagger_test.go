@@ -0,0 +1,115 @@
+package fade
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	optional "github.com/moznion/go-optional"
+)
+
+func offsets(s Stagger, count int) []float32 {
+	out := make([]float32, count)
+	for i := range out {
+		out[i] = s.Offset(i, count)
+	}
+	return out
+}
+
+func TestStaggerPatterns(t *testing.T) {
+	sq := float32(math.Sqrt2) * 0.1
+	for _, tc := range []struct {
+		name  string
+		s     Stagger
+		count int
+		want  []float32
+		span  float32
+	}{
+		{"start", Stagger{Each: 0.1}, 5, []float32{0, 0.1, 0.2, 0.3, 0.4}, 0.4},
+		{"end", Stagger{Each: 0.1, From: StaggerFromEnd}, 5, []float32{0.4, 0.3, 0.2, 0.1, 0}, 0.4},
+		{"center odd", Stagger{Each: 0.1, From: StaggerFromCenter}, 5, []float32{0.2, 0.1, 0, 0.1, 0.2}, 0.2},
+		{"center even", Stagger{Each: 0.1, From: StaggerFromCenter}, 4, []float32{0.15, 0.05, 0.05, 0.15}, 0.15},
+		{"eased", Stagger{Each: 0.1, Ease: func(x float32) float32 { return x * x }}, 5, []float32{0, 0.025, 0.1, 0.225, 0.4}, 0.4},
+		{"single", Stagger{Each: 0.1}, 1, []float32{0}, 0},
+		{"grid start", Stagger{Each: 0.1, Cols: 3}, 9, []float32{0, 0.1, 0.2, 0.1, sq, 0.2236068, 0.2, 0.2236068, 2 * sq}, 2 * sq},
+		{"grid center", Stagger{Each: 0.1, Cols: 3, From: StaggerFromCenter}, 9, []float32{sq, 0.1, sq, 0.1, 0, 0.1, sq, 0.1, sq}, sq},
+		{"grid end", Stagger{Each: 0.1, Cols: 3, From: StaggerFromEnd}, 9, []float32{2 * sq, 0.2236068, 0.2, 0.2236068, sq, 0.1, 0.2, 0.1, 0}, 2 * sq},
+		// Items past the first rows add rows instead of leaving the grid.
+		{"grid taller than wide", Stagger{Each: 0.1, Cols: 2}, 6, []float32{0, 0.1, 0.1, sq, 0.2, 0.2236068}, 0.2236068},
+		// The end is the last item, not the empty corner of a partial row.
+		{"grid partial row", Stagger{Each: 0.1, Cols: 3, From: StaggerFromEnd}, 7, []float32{0.2, 0.2236068, 2 * sq, 0.1, sq, 0.2236068, 0}, 2 * sq},
+		{"grid narrower than Cols", Stagger{Each: 0.1, Cols: 4}, 3, []float32{0, 0.1, 0.2}, 0.2},
+	} {
+		got := offsets(tc.s, tc.count)
+		for i := range got {
+			if !approx(got[i], tc.want[i]) {
+				t.Errorf("%s: offsets = %v, want %v", tc.name, got, tc.want)
+				break
+			}
+		}
+		if span := tc.s.Span(tc.count); !approx(span, tc.span) {
+			t.Errorf("%s: Span = %v, want %v", tc.name, span, tc.span)
+		}
+	}
+}
+
+func TestStaggerSpanBoundsOffsets(t *testing.T) {
+	for _, from := range []StaggerFrom{StaggerFromStart, StaggerFromEnd, StaggerFromCenter, StaggerRandom} {
+		for cols := 0; cols <= 4; cols++ {
+			for count := 1; count <= 10; count++ {
+				s := Stagger{Each: 0.1, From: from, Cols: cols, Seed: 3}
+				name := fmt.Sprintf("from %d, %d cols, %d items", from, cols, count)
+				span := s.Span(count)
+				var last float32
+				for i, o := range offsets(s, count) {
+					if o < 0 || o > span+epsilon {
+						t.Errorf("%s: Offset(%d) = %v outside [0, %v]", name, i, o, span)
+					}
+					last = max(last, o)
+				}
+				if from != StaggerRandom && !approx(last, span) {
+					t.Errorf("%s: latest offset = %v, want Span %v", name, last, span)
+				}
+			}
+		}
+	}
+}
+
+func TestStaggerRandom(t *testing.T) {
+	s := Stagger{Each: 0.1, From: StaggerRandom, Seed: 42}
+	a, b := offsets(s, 8), offsets(s, 8)
+	s.Seed = 43
+	c := offsets(s, 8)
+	same, distinct := true, false
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("same seed gave %v and %v", a, b)
+		}
+		same = same && a[i] == c[i]
+		distinct = distinct || a[i] != a[0]
+	}
+	if same {
+		t.Errorf("seeds 42 and 43 gave the same offsets %v", a)
+	}
+	if !distinct {
+		t.Errorf("random offsets are all equal: %v", a)
+	}
+}
+
+func TestStaggerSample(t *testing.T) {
+	s := Stagger{Each: 0.5}
+	if got := s.Sample(0.25, 1, 3, 1, 1, optional.Some[float32](1), nil, nil); got.Phase != Delay || got.Alpha != 0 {
+		t.Errorf("item 1 at 0.25s = %+v, want Delay with alpha 0", got)
+	}
+	if a := s.AlphaValue(1, 1, 3, 1, 1, 1); !approx(a, 0.5) {
+		t.Errorf("item 1 at 1s: alpha = %v, want 0.5", a)
+	}
+	if d := s.DeltaValue(1, 2, 3, 1, 1, 1, 10); d != 0 {
+		t.Errorf("item 2 at 1s: delta = %v, want 0", d)
+	}
+	var phase Phase
+	s.AlphaMore(2, 0, 3, 1, 1, optional.Some[float32](1), func(alpha, rateEasing, rateTime float32, p Phase) { phase = p }, nil, nil)
+	if phase != FadeOut {
+		t.Errorf("item 0 at 2s: phase = %v, want FadeOut", phase)
+	}
+}
@@ -0,0 +1,94 @@
+package fade
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/funatsufumiya/ebiten_fade/fade/easing"
+)
+
+var easingFunctionNames = [...]string{
+	Linear:   "linear",
+	Quad:     "quad",
+	Cubic:    "cubic",
+	Quart:    "quart",
+	Quint:    "quint",
+	Sine:     "sine",
+	Expo:     "expo",
+	Circular: "circular",
+	Back:     "back",
+	Elastic:  "elastic",
+	Bounce:   "bounce",
+}
+
+var easingTypeNames = [...]string{
+	In:    "in",
+	Out:   "out",
+	InOut: "in-out",
+}
+
+func (f EasingFunction) String() string {
+	if f < 0 || int(f) >= len(easingFunctionNames) {
+		return fmt.Sprintf("EasingFunction(%d)", int(f))
+	}
+	return easingFunctionNames[f]
+}
+
+func (f EasingFunction) MarshalText() ([]byte, error) {
+	if f < 0 || int(f) >= len(easingFunctionNames) {
+		return nil, fmt.Errorf("fade: invalid easing function %d", int(f))
+	}
+	return []byte(easingFunctionNames[f]), nil
+}
+
+func (f *EasingFunction) UnmarshalText(text []byte) error {
+	name := strings.ToLower(strings.TrimSpace(string(text)))
+	if name == "circ" {
+		name = "circular"
+	}
+	for i, n := range easingFunctionNames {
+		if n == name {
+			*f = EasingFunction(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("fade: unknown easing function %q", text)
+}
+
+func (t EasingType) String() string {
+	if t < 0 || int(t) >= len(easingTypeNames) {
+		return fmt.Sprintf("EasingType(%d)", int(t))
+	}
+	return easingTypeNames[t]
+}
+
+func (t EasingType) MarshalText() ([]byte, error) {
+	if t < 0 || int(t) >= len(easingTypeNames) {
+		return nil, fmt.Errorf("fade: invalid easing type %d", int(t))
+	}
+	return []byte(easingTypeNames[t]), nil
+}
+
+func (t *EasingType) UnmarshalText(text []byte) error {
+	name := strings.ToLower(strings.TrimSpace(string(text)))
+	if name == "inout" || name == "in_out" {
+		name = "in-out"
+	}
+	for i, n := range easingTypeNames {
+		if n == name {
+			*t = EasingType(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("fade: unknown easing type %q", text)
+}
+
+// ParseEaser returns the Easer described by s, using the syntax of easing.Parse
+// (e.g. "cubic-out", "ease-in-out", "cubic-bezier(.17,.67,.83,.67)", "steps(4, jump-end)").
+func ParseEaser(s string) (Easer, error) {
+	fn, err := easing.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return fn, nil
+}
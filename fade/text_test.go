@@ -0,0 +1,97 @@
+package fade
+
+import (
+	"testing"
+)
+
+func TestEasingFunctionTextRoundTrip(t *testing.T) {
+	for f := Linear; f <= Bounce; f++ {
+		text, err := f.MarshalText()
+		if err != nil {
+			t.Fatalf("%d.MarshalText error: %v", int(f), err)
+		}
+		var got EasingFunction
+		if err := got.UnmarshalText(text); err != nil {
+			t.Fatalf("UnmarshalText(%q) error: %v", text, err)
+		}
+		if got != f {
+			t.Errorf("round trip of %v through %q = %v", f, text, got)
+		}
+	}
+}
+
+func TestEasingTypeTextRoundTrip(t *testing.T) {
+	for e := In; e <= InOut; e++ {
+		text, err := e.MarshalText()
+		if err != nil {
+			t.Fatalf("%d.MarshalText error: %v", int(e), err)
+		}
+		var got EasingType
+		if err := got.UnmarshalText(text); err != nil {
+			t.Fatalf("UnmarshalText(%q) error: %v", text, err)
+		}
+		if got != e {
+			t.Errorf("round trip of %v through %q = %v", e, text, got)
+		}
+	}
+}
+
+func TestEasingTextAliases(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want EasingFunction
+	}{
+		{"circ", Circular},
+		{" Cubic ", Cubic},
+		{"ELASTIC", Elastic},
+	} {
+		var got EasingFunction
+		if err := got.UnmarshalText([]byte(tc.in)); err != nil || got != tc.want {
+			t.Errorf("EasingFunction.UnmarshalText(%q) = %v, %v; want %v", tc.in, got, err, tc.want)
+		}
+	}
+	for _, tc := range []struct {
+		in   string
+		want EasingType
+	}{
+		{"inout", InOut},
+		{"in_out", InOut},
+		{"IN-OUT", InOut},
+		{"Out", Out},
+	} {
+		var got EasingType
+		if err := got.UnmarshalText([]byte(tc.in)); err != nil || got != tc.want {
+			t.Errorf("EasingType.UnmarshalText(%q) = %v, %v; want %v", tc.in, got, err, tc.want)
+		}
+	}
+}
+
+func TestEasingTextErrors(t *testing.T) {
+	var f EasingFunction
+	if err := f.UnmarshalText([]byte("wobble")); err == nil || err.Error() != `fade: unknown easing function "wobble"` {
+		t.Errorf("EasingFunction.UnmarshalText(wobble) error = %v", err)
+	}
+	var e EasingType
+	if err := e.UnmarshalText([]byte("sideways")); err == nil || err.Error() != `fade: unknown easing type "sideways"` {
+		t.Errorf("EasingType.UnmarshalText(sideways) error = %v", err)
+	}
+	if _, err := EasingFunction(99).MarshalText(); err == nil {
+		t.Error("EasingFunction(99).MarshalText succeeded")
+	}
+	if _, err := EasingType(-1).MarshalText(); err == nil {
+		t.Error("EasingType(-1).MarshalText succeeded")
+	}
+}
+
+func TestParseEaser(t *testing.T) {
+	e, err := ParseEaser("cubic-bezier(.17,.67,.83,.67)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := e(1); got != 1 {
+		t.Errorf("ParseEaser(cubic-bezier)(1) = %v, want 1", got)
+	}
+	if _, err := ParseEaser("cubic-bezier(nan,0,1,1)"); err == nil {
+		t.Error("ParseEaser accepted a NaN cubic-bezier argument")
+	}
+}
@@ -0,0 +1,87 @@
+package fade
+
+import (
+	optional "github.com/moznion/go-optional"
+)
+
+// DefaultTPS is the tick rate Update assumes unless WithTPS is given. It
+// matches ebiten's default.
+const DefaultTPS = 60
+
+// tickSeconds returns the duration of a single tick in seconds.
+func tickSeconds(tps func() float64) float64 {
+	rate := float64(DefaultTPS)
+	if tps != nil {
+		if r := tps(); r > 0 {
+			rate = r
+		}
+	}
+	return 1 / rate
+}
+
+var (
+	_ Fader = (*TickInteractiveFader)(nil)
+	_ Fader = (*TickNonInteractiveFader)(nil)
+)
+
+// TickInteractiveFader is an InteractiveFader driven by game ticks instead of
+// wall time. Call Update once per game update; the fade freezes whenever
+// Update is not called.
+type TickInteractiveFader struct {
+	*InteractiveFader
+	clock *ManualClock
+	tps   func() float64
+}
+
+func NewTickInteractiveFader(fadeInSec float32, fadeOutSec optional.Option[float32], opts ...FaderOption) *TickInteractiveFader {
+	clock := NewManualClock()
+	return &TickInteractiveFader{
+		InteractiveFader: NewInteractiveFader(fadeInSec, fadeOutSec, append(opts[:len(opts):len(opts)], WithClock(clock))...),
+		clock:            clock,
+		tps:              newFaderOptions(opts).tps,
+	}
+}
+
+// Update advances the fader by one tick (1/DefaultTPS seconds, or as set by
+// WithTPS) and fires pending callbacks.
+func (f *TickInteractiveFader) Update() {
+	f.clock.AdvanceSeconds(tickSeconds(f.tps))
+	f.InteractiveFader.Update()
+}
+
+// UpdateDelta advances the fader by dt seconds and fires pending callbacks.
+func (f *TickInteractiveFader) UpdateDelta(dt float32) {
+	f.clock.AdvanceSeconds(float64(dt))
+	f.InteractiveFader.Update()
+}
+
+// TickNonInteractiveFader is a NonInteractiveFader driven by game ticks
+// instead of wall time. Call Update once per game update; the fade freezes
+// whenever Update is not called.
+type TickNonInteractiveFader struct {
+	*NonInteractiveFader
+	clock *ManualClock
+	tps   func() float64
+}
+
+func NewTickNonInteractiveFader(fadeInSec, staticSec float32, fadeOutSec optional.Option[float32], opts ...FaderOption) *TickNonInteractiveFader {
+	clock := NewManualClock()
+	return &TickNonInteractiveFader{
+		NonInteractiveFader: NewNonInteractiveFader(fadeInSec, staticSec, fadeOutSec, append(opts[:len(opts):len(opts)], WithClock(clock))...),
+		clock:               clock,
+		tps:                 newFaderOptions(opts).tps,
+	}
+}
+
+// Update advances the fader by one tick (1/DefaultTPS seconds, or as set by
+// WithTPS) and fires pending callbacks.
+func (f *TickNonInteractiveFader) Update() {
+	f.clock.AdvanceSeconds(tickSeconds(f.tps))
+	f.NonInteractiveFader.Update()
+}
+
+// UpdateDelta advances the fader by dt seconds and fires pending callbacks.
+func (f *TickNonInteractiveFader) UpdateDelta(dt float32) {
+	f.clock.AdvanceSeconds(float64(dt))
+	f.NonInteractiveFader.Update()
+}
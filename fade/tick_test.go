@@ -0,0 +1,74 @@
+package fade
+
+import (
+	"testing"
+
+	optional "github.com/moznion/go-optional"
+)
+
+func TestTickFadersMatchClockFaders(t *testing.T) {
+	clock := NewManualClock()
+	ni := NewNonInteractiveFader(1, 0.5, optional.Some[float32](1), WithClock(clock))
+	tni := NewTickNonInteractiveFader(1, 0.5, optional.Some[float32](1))
+	in := NewInteractiveFader(1, optional.Some[float32](1), WithClock(clock))
+	tin := NewTickInteractiveFader(1, optional.Some[float32](1))
+	ni.Start()
+	tni.Start()
+	in.Start()
+	tin.Start()
+
+	delta := func(f Fader) Sample {
+		return f.SampleMoreEase(NewEaser(Cubic, Out), NewEaser(Back, In)).WithDelta(10)
+	}
+	for i, dt := range []float32{0.25, 0.5, 0.125, 0.375, 0.25, 0.5, 0.5, 1} {
+		clock.AdvanceSeconds(float64(dt))
+		tni.UpdateDelta(dt)
+		tin.UpdateDelta(dt)
+		if i == 4 {
+			in.FadeOut(false)
+			tin.FadeOut(false)
+		}
+		for _, pair := range []struct {
+			name       string
+			want, tick Fader
+		}{
+			{"NonInteractive", ni, tni},
+			{"Interactive", in, tin},
+		} {
+			if got, want := alphaOf(pair.tick), alphaOf(pair.want); got != want {
+				t.Errorf("step %d: Tick%sFader alpha = %v, want %v", i, pair.name, got, want)
+			}
+			if got, want := delta(pair.tick), delta(pair.want); got != want {
+				t.Errorf("step %d: Tick%sFader sample = %+v, want %+v", i, pair.name, got, want)
+			}
+			if got, want := pair.tick.IsFinished(), pair.want.IsFinished(); got != want {
+				t.Errorf("step %d: Tick%sFader IsFinished = %v, want %v", i, pair.name, got, want)
+			}
+		}
+	}
+}
+
+func TestTickFaderUpdateUsesTPS(t *testing.T) {
+	f := NewTickNonInteractiveFader(1, 0, optional.Some[float32](1))
+	f.Start()
+	for range DefaultTPS / 2 {
+		f.Update()
+	}
+	if a := alphaOf(f); !approx(a, 0.5) {
+		t.Errorf("alpha after %d ticks at DefaultTPS = %v, want 0.5", DefaultTPS/2, a)
+	}
+
+	tps := 10.0
+	f = NewTickNonInteractiveFader(1, 0, optional.Some[float32](1), WithTPS(func() float64 { return tps }))
+	f.Start()
+	f.Update()
+	f.Update()
+	if a := alphaOf(f); !approx(a, 0.2) {
+		t.Errorf("alpha after 2 ticks at 10 TPS = %v, want 0.2", a)
+	}
+	tps = 0
+	f.Update()
+	if a := alphaOf(f); !approx(a, 0.2+1.0/DefaultTPS) {
+		t.Errorf("alpha after a tick at 0 TPS = %v, want %v", a, 0.2+1.0/DefaultTPS)
+	}
+}
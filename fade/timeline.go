@@ -0,0 +1,108 @@
+package fade
+
+import "sort"
+
+// Keyframe is a point on a Timeline. Easing shapes the segment that ends at
+// this keyframe; a nil Easing is linear. The first keyframe's Easing is unused.
+type Keyframe struct {
+	Time   float32
+	Value  float32
+	Easing Easer
+}
+
+// Timeline interpolates a value through an arbitrary sequence of keyframes,
+// e.g. 0→0.6 (quad-out), hold, 0.6→1 (back-out), 1→0 (expo-in).
+type Timeline struct {
+	keys []Keyframe
+}
+
+// NewTimeline returns a Timeline built from keys. Keys are sorted by Time;
+// keys with equal Time keep their order, allowing instant jumps.
+func NewTimeline(keys ...Keyframe) *Timeline {
+	k := make([]Keyframe, len(keys))
+	copy(k, keys)
+	sort.SliceStable(k, func(i, j int) bool { return k[i].Time < k[j].Time })
+	return &Timeline{keys: k}
+}
+
+// Hold appends a keyframe that keeps the last value for sec seconds.
+func (tl *Timeline) Hold(sec float32) *Timeline {
+	var v float32
+	if len(tl.keys) > 0 {
+		v = tl.keys[len(tl.keys)-1].Value
+	}
+	return tl.To(sec, v, nil)
+}
+
+// To appends a segment that moves to value over sec seconds using e.
+// On an empty Timeline the segment starts from value 0 at time 0.
+func (tl *Timeline) To(sec, value float32, e Easer) *Timeline {
+	if len(tl.keys) == 0 {
+		tl.keys = append(tl.keys, Keyframe{})
+	}
+	start := tl.keys[len(tl.keys)-1].Time
+	tl.keys = append(tl.keys, Keyframe{Time: start + sec, Value: value, Easing: e})
+	return tl
+}
+
+// Keyframes returns a copy of the timeline's keyframes in time order.
+func (tl *Timeline) Keyframes() []Keyframe {
+	return append([]Keyframe(nil), tl.keys...)
+}
+
+// Duration returns the time of the last keyframe.
+func (tl *Timeline) Duration() float32 {
+	if len(tl.keys) == 0 {
+		return 0
+	}
+	return tl.keys[len(tl.keys)-1].Time
+}
+
+// Segments returns the number of segments (len(keyframes) - 1).
+func (tl *Timeline) Segments() int {
+	if len(tl.keys) < 2 {
+		return 0
+	}
+	return len(tl.keys) - 1
+}
+
+// Sample returns the value at time t and the index of the active segment.
+// Segment i runs from keyframe i to keyframe i+1. Before the first keyframe
+// the segment is -1; after the last it is Segments().
+func (tl *Timeline) Sample(t float32) (value float32, segment int) {
+	switch len(tl.keys) {
+	case 0:
+		return 0, -1
+	case 1:
+		if t < tl.keys[0].Time {
+			return tl.keys[0].Value, -1
+		}
+		return tl.keys[0].Value, 0
+	}
+
+	first, last := tl.keys[0], tl.keys[len(tl.keys)-1]
+	if t < first.Time {
+		return first.Value, -1
+	}
+	if t >= last.Time {
+		return last.Value, len(tl.keys) - 1
+	}
+
+	// index of the first keyframe strictly after t
+	i := sort.Search(len(tl.keys), func(i int) bool { return tl.keys[i].Time > t })
+	from, to := tl.keys[i-1], tl.keys[i]
+	rate := (t - from.Time) / (to.Time - from.Time)
+	return from.Value + (to.Value-from.Value)*to.Easing.ease(rate), i - 1
+}
+
+// Value returns the value at time t.
+func (tl *Timeline) Value(t float32) float32 {
+	v, _ := tl.Sample(t)
+	return v
+}
+
+// Segment returns the index of the segment active at time t.
+func (tl *Timeline) Segment(t float32) int {
+	_, s := tl.Sample(t)
+	return s
+}
@@ -0,0 +1,108 @@
+package fade
+
+import "testing"
+
+func TestTimelineSample(t *testing.T) {
+	quad := func(x float32) float32 { return x * x }
+	tl := NewTimeline(
+		Keyframe{Time: 3, Value: 0},
+		Keyframe{Time: 1, Value: 1, Easing: quad},
+		Keyframe{Time: 0, Value: 0},
+		// Equal times jump instantly from the earlier key to the later one.
+		Keyframe{Time: 2, Value: 1},
+		Keyframe{Time: 2, Value: 0.5},
+	)
+	if got := tl.Segments(); got != 4 {
+		t.Fatalf("Segments() = %d, want 4", got)
+	}
+	if got := tl.Duration(); got != 3 {
+		t.Fatalf("Duration() = %v, want 3", got)
+	}
+	for _, tc := range []struct {
+		t       float32
+		value   float32
+		segment int
+	}{
+		{-1, 0, -1},
+		{0, 0, 0},
+		{0.5, 0.25, 0},
+		{1, 1, 1},
+		{1.5, 1, 1},
+		{1.999, 1, 1},
+		{2, 0.5, 3},
+		{2.5, 0.25, 3},
+		{3, 0, 4},
+		{10, 0, 4},
+	} {
+		v, s := tl.Sample(tc.t)
+		if !approx(v, tc.value) || s != tc.segment {
+			t.Errorf("Sample(%v) = %v, %d; want %v, %d", tc.t, v, s, tc.value, tc.segment)
+		}
+		if got := tl.Value(tc.t); got != v {
+			t.Errorf("Value(%v) = %v, want %v", tc.t, got, v)
+		}
+		if got := tl.Segment(tc.t); got != s {
+			t.Errorf("Segment(%v) = %d, want %d", tc.t, got, s)
+		}
+	}
+}
+
+func TestTimelineShortKeys(t *testing.T) {
+	var empty Timeline
+	if v, s := empty.Sample(1); v != 0 || s != -1 {
+		t.Errorf("empty Sample(1) = %v, %d; want 0, -1", v, s)
+	}
+	if empty.Duration() != 0 || empty.Segments() != 0 {
+		t.Errorf("empty Duration() = %v, Segments() = %d", empty.Duration(), empty.Segments())
+	}
+
+	one := NewTimeline(Keyframe{Time: 1, Value: 0.5})
+	for _, tc := range []struct {
+		t       float32
+		segment int
+	}{{0, -1}, {1, 0}, {2, 0}} {
+		if v, s := one.Sample(tc.t); v != 0.5 || s != tc.segment {
+			t.Errorf("single key Sample(%v) = %v, %d; want 0.5, %d", tc.t, v, s, tc.segment)
+		}
+	}
+	if one.Segments() != 0 {
+		t.Errorf("single key Segments() = %d, want 0", one.Segments())
+	}
+}
+
+func TestTimelineBuilder(t *testing.T) {
+	// To on an empty timeline starts from 0 at time 0; Hold keeps the last value.
+	tl := (&Timeline{}).To(1, 0.6, nil).Hold(0.5).To(0.5, 1, nil).To(1, 0, nil)
+	want := []Keyframe{{0, 0, nil}, {1, 0.6, nil}, {1.5, 0.6, nil}, {2, 1, nil}, {3, 0, nil}}
+	keys := tl.Keyframes()
+	if len(keys) != len(want) {
+		t.Fatalf("Keyframes() has %d keys, want %d", len(keys), len(want))
+	}
+	for i, k := range keys {
+		if !approx(k.Time, want[i].Time) || !approx(k.Value, want[i].Value) {
+			t.Errorf("key %d = %v@%v, want %v@%v", i, k.Value, k.Time, want[i].Value, want[i].Time)
+		}
+	}
+	if got := tl.Value(1.25); !approx(got, 0.6) {
+		t.Errorf("Value(1.25) = %v, want 0.6", got)
+	}
+	if got := tl.Value(2.5); !approx(got, 0.5) {
+		t.Errorf("Value(2.5) = %v, want 0.5", got)
+	}
+
+	// Hold on an empty timeline holds 0.
+	held := (&Timeline{}).Hold(2)
+	if got := held.Duration(); got != 2 {
+		t.Errorf("Hold(2) Duration() = %v, want 2", got)
+	}
+	if v, s := held.Sample(1); v != 0 || s != 0 {
+		t.Errorf("Hold(2) Sample(1) = %v, %d; want 0, 0", v, s)
+	}
+
+	// Keyframes returns a copy, so callers cannot break the ordering.
+	keys[0].Time = 10
+	keys[0].Value = 1
+	if got := tl.Value(0); got != 0 {
+		t.Errorf("Value(0) after modifying Keyframes = %v, want 0", got)
+	}
+}
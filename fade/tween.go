@@ -0,0 +1,122 @@
+package fade
+
+import (
+	"image"
+	"image/color"
+
+	math "github.com/chewxy/math32"
+	optional "github.com/moznion/go-optional"
+)
+
+// Interpolator blends a and b by t, where 0 is a and 1 is b. Easings such as
+// Back and Elastic pass t outside [0, 1], so interpolators should extrapolate
+// (or clamp) rather than assume the range.
+type Interpolator[T any] func(a, b T, t float32) T
+
+// Lerper is implemented by types that know how to interpolate themselves.
+type Lerper[T any] interface {
+	Lerp(to T, t float32) T
+}
+
+// Float is the constraint of LerpFloat.
+type Float interface {
+	~float32 | ~float64
+}
+
+// LerpFloat interpolates floating point values.
+func LerpFloat[T Float](a, b T, t float32) T {
+	return a + (b-a)*T(t)
+}
+
+// LerpMethod adapts a Lerper to an Interpolator.
+func LerpMethod[T Lerper[T]](a, b T, t float32) T {
+	return a.Lerp(b, t)
+}
+
+// Vec2 is a 2D vector.
+type Vec2 struct {
+	X, Y float32
+}
+
+func (v Vec2) Lerp(to Vec2, t float32) Vec2 {
+	return Vec2{LerpFloat(v.X, to.X, t), LerpFloat(v.Y, to.Y, t)}
+}
+
+// Vec3 is a 3D vector.
+type Vec3 struct {
+	X, Y, Z float32
+}
+
+func (v Vec3) Lerp(to Vec3, t float32) Vec3 {
+	return Vec3{LerpFloat(v.X, to.X, t), LerpFloat(v.Y, to.Y, t), LerpFloat(v.Z, to.Z, t)}
+}
+
+// LerpNRGBA interpolates each channel of a and b, clamping overshoot.
+func LerpNRGBA(a, b color.NRGBA, t float32) color.NRGBA {
+	ch := func(a, b uint8) uint8 {
+		return uint8(min(max(LerpFloat(float32(a), float32(b), t), 0), 255) + 0.5)
+	}
+	return color.NRGBA{R: ch(a.R, b.R), G: ch(a.G, b.G), B: ch(a.B, b.B), A: ch(a.A, b.A)}
+}
+
+// LerpRect interpolates the corners of a and b, rounding to the nearest pixel.
+func LerpRect(a, b image.Rectangle, t float32) image.Rectangle {
+	ch := func(a, b int) int {
+		return int(math.Round(LerpFloat(float32(a), float32(b), t)))
+	}
+	return image.Rect(ch(a.Min.X, b.Min.X), ch(a.Min.Y, b.Min.Y), ch(a.Max.X, b.Max.X), ch(a.Max.Y, b.Max.Y))
+}
+
+// Tween drives a value of any type through a fade: it goes from From to To
+// during the fade-in, stays at To while static, and returns to From during
+// the fade-out.
+type Tween[T any] struct {
+	From, To T
+	Lerp     Interpolator[T]
+}
+
+func NewTween[T any](from, to T, lerp Interpolator[T]) Tween[T] {
+	return Tween[T]{From: from, To: to, Lerp: lerp}
+}
+
+// NewLerperTween returns a Tween of a type that implements Lerper, such as
+// Vec2 and Vec3.
+func NewLerperTween[T Lerper[T]](from, to T) Tween[T] {
+	return NewTween(from, to, LerpMethod[T])
+}
+
+// At returns the value at eased alpha a.
+func (tw Tween[T]) At(a float32) T {
+	return tw.Lerp(tw.From, tw.To, a)
+}
+
+// Sample returns the value for the state of a fade. It follows the eased
+// alpha before any response curve, so overshoot is kept.
+func (tw Tween[T]) Sample(s Sample) T {
+	return tw.At(s.Linear)
+}
+
+// More returns the value at time t along with the state of the fade, like
+// SampleMore.
+func (tw Tween[T]) More(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	easingFuncIn EasingFunction, easingTypeIn EasingType, easingFuncOut EasingFunction, easingTypeOut EasingType,
+) (T, Sample) {
+	return tw.MoreEase(t, fadeIn, static, fadeOut, NewEaser(easingFuncIn, easingTypeIn), NewEaser(easingFuncOut, easingTypeOut))
+}
+
+// MoreEase is More with arbitrary easing functions.
+func (tw Tween[T]) MoreEase(
+	t, fadeIn, static float32,
+	fadeOut optional.Option[float32],
+	easeIn, easeOut Easer,
+) (T, Sample) {
+	s := SampleMoreEase(t, fadeIn, static, fadeOut, easeIn, easeOut)
+	return tw.Sample(s), s
+}
+
+// Fader returns the current value of f.
+func (tw Tween[T]) Fader(f Fader, easeIn, easeOut Easer) T {
+	return tw.Sample(f.SampleMoreEase(easeIn, easeOut))
+}
@@ -0,0 +1,33 @@
+package fade
+
+import (
+	"testing"
+
+	optional "github.com/moznion/go-optional"
+)
+
+// Tween.Sample used to follow the curve-mapped, clamped alpha, so a fader
+// with an alpha curve bent the motion and cut off overshoot.
+func TestTweenFaderIgnoresAlphaCurve(t *testing.T) {
+	clock := NewManualClock()
+	f := NewNonInteractiveFader(1, 1, optional.Some[float32](1), WithClock(clock), WithAlphaCurve(GammaAlpha))
+	f.Start()
+	clock.AdvanceSeconds(0.5)
+
+	tw := NewLerperTween(Vec2{}, Vec2{X: 100, Y: 100})
+	if got := tw.Fader(f, nil, nil); !approx(got.X, 50) || !approx(got.Y, 50) {
+		t.Errorf("linear tween halfway through the fade-in = %v, want {50 50}", got)
+	}
+	if a := alphaOf(f); !approx(a, GammaAlpha.Apply(0.5)) {
+		t.Errorf("alpha halfway through the fade-in = %v, want %v", a, GammaAlpha.Apply(0.5))
+	}
+
+	back := NewEaser(Back, Out)
+	want := back(0.5) * 100
+	if want <= 100 {
+		t.Fatalf("BackEaseOut(0.5) = %v, want overshoot", want/100)
+	}
+	if got := tw.Fader(f, back, back); !approx(got.X, want) {
+		t.Errorf("BackEaseOut tween halfway through the fade-in = %v, want X = %v", got, want)
+	}
+}